@@ -0,0 +1,134 @@
+package disklayout
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/servers"
+)
+
+// Builder builds a Layout with chained calls. Partitions are added to the
+// RAID array added last, and logical volumes to the volume group added last.
+// The first misuse, such as a partition added before any RAID array, is
+// reported by Build.
+type Builder struct {
+	layout Layout
+	err    error
+}
+
+// NewBuilder returns a Builder of an empty Layout.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// PrimaryRaidArray adds the primary storage RAID array.
+func (b *Builder) PrimaryRaidArray() *Builder {
+	b.layout.RaidArrays = append(b.layout.RaidArrays, servers.CreateOptsRaidArray{
+		PrimaryStorage: true,
+	})
+	return b
+}
+
+// RaidArray adds a RAID array of the given level, built by the RAID card
+// with the given hardware ID from the disks with the given hardware IDs.
+func (b *Builder) RaidArray(raidCardHardwareID string, raidLevel int, diskHardwareIDs ...string) *Builder {
+	b.layout.RaidArrays = append(b.layout.RaidArrays, servers.CreateOptsRaidArray{
+		RaidCardHardwareID: raidCardHardwareID,
+		RaidLevel:          raidLevel,
+		DiskHardwareIDs:    diskHardwareIDs,
+	})
+	return b
+}
+
+// Partition adds a partition with the given label and size, such as "100G",
+// to the last RAID array. An empty size takes the remaining space.
+func (b *Builder) Partition(label, size string) *Builder {
+	return b.partition(label, size, false)
+}
+
+// LVMPartition adds a partition with the given label and size, to be used as
+// a physical volume of a volume group, to the last RAID array. An empty size
+// takes the remaining space.
+func (b *Builder) LVMPartition(label, size string) *Builder {
+	return b.partition(label, size, true)
+}
+
+func (b *Builder) partition(label, size string, lvm bool) *Builder {
+	n := len(b.layout.RaidArrays)
+	if n == 0 {
+		b.fail("disklayout.Builder.Partition", label, "A partition must be added after a RAID array")
+		return b
+	}
+	ra := &b.layout.RaidArrays[n-1]
+	ra.Partitions = append(ra.Partitions, servers.CreateOptsPartition{
+		LVM:            lvm,
+		Size:           size,
+		PartitionLabel: label,
+	})
+	return b
+}
+
+// VolumeGroup adds a volume group with the given label, made of the LVM
+// partitions with the given labels.
+func (b *Builder) VolumeGroup(label string, partitionLabels ...string) *Builder {
+	b.layout.LVMVolumeGroups = append(b.layout.LVMVolumeGroups, servers.CreateOptsLVMVolumeGroup{
+		VGLabel:                       label,
+		PhysicalVolumePartitionLabels: partitionLabels,
+	})
+	return b
+}
+
+// LogicalVolume adds a logical volume with the given label and size to the
+// last volume group. An empty size takes the remaining space.
+func (b *Builder) LogicalVolume(label, size string) *Builder {
+	n := len(b.layout.LVMVolumeGroups)
+	if n == 0 {
+		b.fail("disklayout.Builder.LogicalVolume", label, "A logical volume must be added after a volume group")
+		return b
+	}
+	vg := &b.layout.LVMVolumeGroups[n-1]
+	vg.LogicalVolumes = append(vg.LogicalVolumes, servers.CreateOptsLogicalVolume{
+		LVLabel: label,
+		Size:    size,
+	})
+	return b
+}
+
+// Filesystem adds a filesystem of the given type on the partition or logical
+// volume with the given label, mounted on the given mount point.
+func (b *Builder) Filesystem(label, fsType, mountPoint string) *Builder {
+	b.layout.Filesystems = append(b.layout.Filesystems, servers.CreateOptsFilesystem{
+		Label:      label,
+		FSType:     fsType,
+		MountPoint: mountPoint,
+	})
+	return b
+}
+
+// Swap adds swap space on the partition or logical volume with the given
+// label.
+func (b *Builder) Swap(label string) *Builder {
+	return b.Filesystem(label, FSTypeSwap, "")
+}
+
+func (b *Builder) fail(argument, value, info string) {
+	if b.err != nil {
+		return
+	}
+	err := eclcloud.ErrInvalidInput{}
+	err.Argument = argument
+	err.Value = value
+	err.Info = info
+	b.err = err
+}
+
+// Build validates the layout and returns it. The error is either the first
+// misuse of the Builder or the ErrInvalidLayout returned by Validate.
+func (b *Builder) Build() (*Layout, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if err := b.layout.Validate(); err != nil {
+		return nil, err
+	}
+	layout := b.layout
+	return &layout, nil
+}
@@ -0,0 +1,62 @@
+/*
+Package disklayout builds and validates the disk layout of ECL Baremetal
+Server resources, that is the RaidArrays, LVMVolumeGroups and Filesystems of
+servers.CreateOpts.
+
+These refer to each other by partition, volume group and logical volume
+labels, and the Baremetal Server service only rejects an inconsistent layout
+after the server has started to be provisioned. Validate finds these problems
+beforehand, and reports each of them with a typed error which names the
+offending field.
+
+Example to Build a Disk Layout
+
+	layout, err := disklayout.NewBuilder().
+		PrimaryRaidArray().
+		LVMPartition("primary-part1", "").
+		Partition("var", "100G").
+		RaidArray("raid_card_uuid", 10, "disk1_uuid", "disk2_uuid", "disk3_uuid", "disk4_uuid").
+		LVMPartition("secondary-part1", "").
+		VolumeGroup("VG_root", "primary-part1", "secondary-part1").
+		LogicalVolume("LV_root", "300G").
+		LogicalVolume("LV_swap", "2G").
+		Filesystem("LV_root", "xfs", "/").
+		Filesystem("var", "xfs", "/var").
+		Swap("LV_swap").
+		Build()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := servers.CreateOpts{
+		Name:      "server-test-1",
+		FlavorRef: "flavor-id",
+		ImageRef:  "image-id",
+		Networks: []servers.CreateOptsNetwork{
+			{
+				UUID: "network-id",
+			},
+		},
+	}
+	layout.ApplyTo(&createOpts)
+
+	server, err := servers.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Validate the Disk Layout of Existing Create Options
+
+	err := disklayout.FromCreateOpts(createOpts).Validate()
+	if layoutErr, ok := err.(disklayout.ErrInvalidLayout); ok {
+		for _, e := range layoutErr.Errors {
+			switch e := e.(type) {
+			case disklayout.ErrUnknownLabel:
+				fmt.Printf("%s refers to undefined label %s\n", e.Path, e.Label)
+			default:
+				fmt.Println(e)
+			}
+		}
+	}
+*/
+package disklayout
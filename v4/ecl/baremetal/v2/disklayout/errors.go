@@ -0,0 +1,166 @@
+package disklayout
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrInvalidLayout is the error returned by Validate. It contains all the
+// problems found in a layout, in the order they were found.
+type ErrInvalidLayout struct {
+	eclcloud.BaseError
+	Errors []error
+}
+
+func (e ErrInvalidLayout) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("Invalid disk layout: %s", strings.Join(messages, "; "))
+}
+
+// ErrPrimaryStorageCount is the error when a layout with RAID arrays does not
+// have exactly one primary storage RAID array.
+type ErrPrimaryStorageCount struct {
+	eclcloud.BaseError
+	Count int
+}
+
+func (e ErrPrimaryStorageCount) Error() string {
+	return fmt.Sprintf("Exactly one RAID array must be the primary storage, found %d", e.Count)
+}
+
+// ErrInvalidRaidArray is the error when the RAID card, disks or RAID level of
+// a RAID array are missing or must not be set.
+type ErrInvalidRaidArray struct {
+	eclcloud.BaseError
+	Path string
+	Info string
+}
+
+func (e ErrInvalidRaidArray) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Info)
+}
+
+// ErrInvalidRaidLevel is the error when a RAID level is not supported or the
+// number of disks does not suit it.
+type ErrInvalidRaidLevel struct {
+	eclcloud.BaseError
+	Path      string
+	RaidLevel int
+	Disks     int
+}
+
+func (e ErrInvalidRaidLevel) Error() string {
+	if _, ok := minDisks[e.RaidLevel]; !ok {
+		return fmt.Sprintf("%s: RAID level %d is not supported", e.Path, e.RaidLevel)
+	}
+	return fmt.Sprintf("%s: RAID level %d cannot be built from %d disks", e.Path, e.RaidLevel, e.Disks)
+}
+
+// ErrDuplicateDisk is the error when a disk is used by more than one RAID
+// array, or more than once by the same RAID array.
+type ErrDuplicateDisk struct {
+	eclcloud.BaseError
+	Path       string
+	HardwareID string
+}
+
+func (e ErrDuplicateDisk) Error() string {
+	return fmt.Sprintf("%s: disk %s is already used", e.Path, e.HardwareID)
+}
+
+// ErrInvalidSize is the error when the size of a partition or logical volume
+// is malformed, or is empty where it cannot be.
+type ErrInvalidSize struct {
+	eclcloud.BaseError
+	Path string
+	Size string
+}
+
+func (e ErrInvalidSize) Error() string {
+	if e.Size == "" {
+		return fmt.Sprintf("%s: size is required, another entry already takes the remaining space", e.Path)
+	}
+	return fmt.Sprintf("%s: size %q must be a positive integer followed by M, G or T", e.Path, e.Size)
+}
+
+// ErrMissingLabel is the error when a partition, volume group, logical volume
+// or filesystem has no label, or a volume group has no physical volume
+// partition labels.
+type ErrMissingLabel struct {
+	eclcloud.BaseError
+	Path string
+}
+
+func (e ErrMissingLabel) Error() string {
+	return fmt.Sprintf("%s: label is required", e.Path)
+}
+
+// ErrDuplicateLabel is the error when a label is defined more than once.
+// Partition and logical volume labels share one namespace, because
+// filesystems refer to both.
+type ErrDuplicateLabel struct {
+	eclcloud.BaseError
+	Path  string
+	Label string
+}
+
+func (e ErrDuplicateLabel) Error() string {
+	return fmt.Sprintf("%s: label %q is already defined", e.Path, e.Label)
+}
+
+// ErrUnknownLabel is the error when a label is referred to but not defined.
+type ErrUnknownLabel struct {
+	eclcloud.BaseError
+	Path  string
+	Label string
+}
+
+func (e ErrUnknownLabel) Error() string {
+	return fmt.Sprintf("%s: label %q is not defined", e.Path, e.Label)
+}
+
+// ErrInvalidReference is the error when a label refers to something which
+// cannot be used there, such as a filesystem on an LVM partition.
+type ErrInvalidReference struct {
+	eclcloud.BaseError
+	Path  string
+	Label string
+	Info  string
+}
+
+func (e ErrInvalidReference) Error() string {
+	return fmt.Sprintf("%s: label %q %s", e.Path, e.Label, e.Info)
+}
+
+// ErrInvalidMountPoint is the error when the mount point of a filesystem is
+// missing, malformed or must not be set.
+type ErrInvalidMountPoint struct {
+	eclcloud.BaseError
+	Path       string
+	MountPoint string
+	Info       string
+}
+
+func (e ErrInvalidMountPoint) Error() string {
+	if e.MountPoint == "" {
+		return fmt.Sprintf("%s: mount point %s", e.Path, e.Info)
+	}
+	return fmt.Sprintf("%s: mount point %q %s", e.Path, e.MountPoint, e.Info)
+}
+
+// ErrDuplicateMountPoint is the error when more than one filesystem is
+// mounted on the same mount point.
+type ErrDuplicateMountPoint struct {
+	eclcloud.BaseError
+	Path       string
+	MountPoint string
+}
+
+func (e ErrDuplicateMountPoint) Error() string {
+	return fmt.Sprintf("%s: mount point %q is already used", e.Path, e.MountPoint)
+}
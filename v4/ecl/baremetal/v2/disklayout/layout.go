@@ -0,0 +1,246 @@
+package disklayout
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+
+	"github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/servers"
+)
+
+// FSTypeSwap is the filesystem type of swap space, which has no mount point.
+const FSTypeSwap = "swap"
+
+// minDisks maps the supported RAID levels to the minimum number of disks
+// they are built from. RAID 0 is not supported, because a RaidLevel of 0 is
+// omitted from the request.
+var minDisks = map[int]int{
+	1:  2,
+	5:  3,
+	6:  4,
+	10: 4,
+}
+
+// sizePattern matches the sizes of partitions and logical volumes.
+var sizePattern = regexp.MustCompile(`^[1-9][0-9]*[MGT]$`)
+
+// Layout is the disk layout of a baremetal server.
+type Layout struct {
+	RaidArrays      []servers.CreateOptsRaidArray
+	LVMVolumeGroups []servers.CreateOptsLVMVolumeGroup
+	Filesystems     []servers.CreateOptsFilesystem
+}
+
+// FromCreateOpts returns the disk layout of opts.
+func FromCreateOpts(opts servers.CreateOpts) Layout {
+	return Layout{
+		RaidArrays:      opts.RaidArrays,
+		LVMVolumeGroups: opts.LVMVolumeGroups,
+		Filesystems:     opts.Filesystems,
+	}
+}
+
+// ApplyTo sets the disk layout of opts to the layout.
+func (l Layout) ApplyTo(opts *servers.CreateOpts) {
+	opts.RaidArrays = l.RaidArrays
+	opts.LVMVolumeGroups = l.LVMVolumeGroups
+	opts.Filesystems = l.Filesystems
+}
+
+// label is a partition or logical volume label which filesystems refer to.
+type label struct {
+	path string
+	lvm  bool
+	lv   bool
+}
+
+// Validate checks the layout before a server is created with it. It returns
+// an ErrInvalidLayout containing a typed error for every problem found:
+//
+//   - a layout with RAID arrays must have exactly one primary storage RAID
+//     array, which has no RAID card, disks or RAID level, while every other
+//     RAID array has all three, with a RAID level of 1, 5, 6 or 10 and a
+//     suitable number of disks that no other RAID array uses;
+//   - sizes are positive integers followed by M, G or T, and only one
+//     partition of a RAID array and one logical volume of a volume group may
+//     omit its size to take the remaining space;
+//   - partition and logical volume labels, and volume group labels, are
+//     defined once, and volume groups and filesystems only refer to defined
+//     labels: volume groups to LVM partitions not used by another volume
+//     group, filesystems to logical volumes or non-LVM partitions not used
+//     by another filesystem;
+//   - filesystems other than swap are mounted on unique, absolute and clean
+//     mount points, and swap has no mount point.
+func (l Layout) Validate() error {
+	var errs []error
+	add := func(err error) {
+		errs = append(errs, err)
+	}
+
+	labels := make(map[string]label)
+	define := func(p, name string, lvm, lv bool) {
+		if name == "" {
+			add(ErrMissingLabel{Path: p})
+			return
+		}
+		if _, ok := labels[name]; ok {
+			add(ErrDuplicateLabel{Path: p, Label: name})
+			return
+		}
+		labels[name] = label{path: p, lvm: lvm, lv: lv}
+	}
+
+	l.validateRaidArrays(add)
+
+	for i, ra := range l.RaidArrays {
+		sized := true
+		for j, p := range ra.Partitions {
+			pp := fmt.Sprintf("RaidArrays[%d].Partitions[%d]", i, j)
+			define(pp+".PartitionLabel", p.PartitionLabel, p.LVM, false)
+			validateSize(add, pp+".Size", p.Size, &sized)
+		}
+	}
+
+	vgLabels := make(map[string]bool)
+	usedPVs := make(map[string]bool)
+	for i, vg := range l.LVMVolumeGroups {
+		vp := fmt.Sprintf("LVMVolumeGroups[%d]", i)
+		if vg.VGLabel == "" {
+			add(ErrMissingLabel{Path: vp + ".VGLabel"})
+		} else if vgLabels[vg.VGLabel] {
+			add(ErrDuplicateLabel{Path: vp + ".VGLabel", Label: vg.VGLabel})
+		}
+		vgLabels[vg.VGLabel] = true
+
+		if len(vg.PhysicalVolumePartitionLabels) == 0 {
+			add(ErrMissingLabel{Path: vp + ".PhysicalVolumePartitionLabels"})
+		}
+		for j, name := range vg.PhysicalVolumePartitionLabels {
+			pp := fmt.Sprintf("%s.PhysicalVolumePartitionLabels[%d]", vp, j)
+			target, ok := labels[name]
+			switch {
+			case !ok:
+				add(ErrUnknownLabel{Path: pp, Label: name})
+			case target.lv:
+				add(ErrInvalidReference{Path: pp, Label: name, Info: "is a logical volume, not a partition"})
+			case !target.lvm:
+				add(ErrInvalidReference{Path: pp, Label: name, Info: "is not an LVM partition"})
+			case usedPVs[name]:
+				add(ErrInvalidReference{Path: pp, Label: name, Info: "is already used by another volume group"})
+			}
+			usedPVs[name] = true
+		}
+
+		sized := true
+		for j, lv := range vg.LogicalVolumes {
+			lp := fmt.Sprintf("%s.LogicalVolumes[%d]", vp, j)
+			define(lp+".LVLabel", lv.LVLabel, false, true)
+			validateSize(add, lp+".Size", lv.Size, &sized)
+		}
+	}
+
+	mountPoints := make(map[string]bool)
+	usedLabels := make(map[string]bool)
+	for i, fs := range l.Filesystems {
+		fp := fmt.Sprintf("Filesystems[%d]", i)
+
+		target, ok := labels[fs.Label]
+		switch {
+		case fs.Label == "":
+			add(ErrMissingLabel{Path: fp + ".Label"})
+		case !ok:
+			add(ErrUnknownLabel{Path: fp + ".Label", Label: fs.Label})
+		case target.lvm:
+			add(ErrInvalidReference{Path: fp + ".Label", Label: fs.Label, Info: "is an LVM partition, which cannot hold a filesystem"})
+		case usedLabels[fs.Label]:
+			add(ErrInvalidReference{Path: fp + ".Label", Label: fs.Label, Info: "already holds another filesystem"})
+		}
+		usedLabels[fs.Label] = true
+
+		mp := fp + ".MountPoint"
+		switch {
+		case fs.FSType == FSTypeSwap:
+			if fs.MountPoint != "" {
+				add(ErrInvalidMountPoint{Path: mp, MountPoint: fs.MountPoint, Info: "must not be set for swap"})
+			}
+		case fs.MountPoint == "":
+			add(ErrInvalidMountPoint{Path: mp, Info: "is required"})
+		case !path.IsAbs(fs.MountPoint) || path.Clean(fs.MountPoint) != fs.MountPoint:
+			add(ErrInvalidMountPoint{Path: mp, MountPoint: fs.MountPoint, Info: "must be an absolute, clean path"})
+		case mountPoints[fs.MountPoint]:
+			add(ErrDuplicateMountPoint{Path: mp, MountPoint: fs.MountPoint})
+		}
+		if fs.MountPoint != "" {
+			mountPoints[fs.MountPoint] = true
+		}
+	}
+
+	if len(errs) > 0 {
+		return ErrInvalidLayout{Errors: errs}
+	}
+	return nil
+}
+
+// validateRaidArrays checks the primary storage, RAID cards, disks and RAID
+// levels of the RAID arrays.
+func (l Layout) validateRaidArrays(add func(error)) {
+	if len(l.RaidArrays) == 0 {
+		return
+	}
+
+	primary := 0
+	disks := make(map[string]bool)
+	for i, ra := range l.RaidArrays {
+		rp := fmt.Sprintf("RaidArrays[%d]", i)
+
+		if ra.PrimaryStorage {
+			primary++
+			if ra.RaidCardHardwareID != "" || len(ra.DiskHardwareIDs) > 0 || ra.RaidLevel != 0 {
+				add(ErrInvalidRaidArray{Path: rp, Info: "the primary storage cannot have a RAID card, disks or RAID level"})
+			}
+			continue
+		}
+
+		if ra.RaidCardHardwareID == "" {
+			add(ErrInvalidRaidArray{Path: rp + ".RaidCardHardwareID", Info: "a RAID card is required"})
+		}
+		if len(ra.DiskHardwareIDs) == 0 {
+			add(ErrInvalidRaidArray{Path: rp + ".DiskHardwareIDs", Info: "disks are required"})
+		}
+
+		for j, id := range ra.DiskHardwareIDs {
+			if disks[id] {
+				add(ErrDuplicateDisk{Path: fmt.Sprintf("%s.DiskHardwareIDs[%d]", rp, j), HardwareID: id})
+			}
+			disks[id] = true
+		}
+
+		n := len(ra.DiskHardwareIDs)
+		min, ok := minDisks[ra.RaidLevel]
+		switch {
+		case ra.RaidLevel == 0:
+			add(ErrInvalidRaidArray{Path: rp + ".RaidLevel", Info: "a RAID level is required"})
+		case !ok || (n > 0 && n < min) || ((ra.RaidLevel == 1 || ra.RaidLevel == 10) && n%2 != 0):
+			add(ErrInvalidRaidLevel{Path: rp + ".RaidLevel", RaidLevel: ra.RaidLevel, Disks: n})
+		}
+	}
+
+	if primary != 1 {
+		add(ErrPrimaryStorageCount{Count: primary})
+	}
+}
+
+// validateSize checks the size of a partition or logical volume. sized is
+// true until one of its siblings has omitted its size.
+func validateSize(add func(error), p, size string, sized *bool) {
+	if size == "" {
+		if !*sized {
+			add(ErrInvalidSize{Path: p})
+		}
+		*sized = false
+		return
+	}
+	if !sizePattern.MatchString(size) {
+		add(ErrInvalidSize{Path: p, Size: size})
+	}
+}
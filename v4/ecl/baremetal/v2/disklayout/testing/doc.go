@@ -0,0 +1,2 @@
+// Package testing contains disklayout unit tests
+package testing
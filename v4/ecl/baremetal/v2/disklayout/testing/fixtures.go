@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/disklayout"
+	"github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/servers"
+)
+
+// expectedLayout is the layout built in TestBuildLayout.
+var expectedLayout = disklayout.Layout{
+	RaidArrays: []servers.CreateOptsRaidArray{
+		{
+			PrimaryStorage: true,
+			Partitions: []servers.CreateOptsPartition{
+				{LVM: true, PartitionLabel: "primary-part1"},
+				{Size: "100G", PartitionLabel: "var"},
+			},
+		},
+		{
+			RaidCardHardwareID: "raid_card_uuid",
+			DiskHardwareIDs:    []string{"disk1_uuid", "disk2_uuid", "disk3_uuid", "disk4_uuid"},
+			RaidLevel:          10,
+			Partitions: []servers.CreateOptsPartition{
+				{LVM: true, PartitionLabel: "secondary-part1"},
+			},
+		},
+	},
+	LVMVolumeGroups: []servers.CreateOptsLVMVolumeGroup{
+		{
+			VGLabel:                       "VG_root",
+			PhysicalVolumePartitionLabels: []string{"primary-part1", "secondary-part1"},
+			LogicalVolumes: []servers.CreateOptsLogicalVolume{
+				{LVLabel: "LV_root", Size: "300G"},
+				{LVLabel: "LV_swap", Size: "2G"},
+			},
+		},
+	},
+	Filesystems: []servers.CreateOptsFilesystem{
+		{Label: "LV_root", FSType: "xfs", MountPoint: "/"},
+		{Label: "var", FSType: "xfs", MountPoint: "/var"},
+		{Label: "LV_swap", FSType: "swap"},
+	},
+}
+
+// invalidLayout contains one of each problem Validate finds.
+var invalidLayout = disklayout.Layout{
+	RaidArrays: []servers.CreateOptsRaidArray{
+		{
+			PrimaryStorage: true,
+			RaidLevel:      1,
+			Partitions: []servers.CreateOptsPartition{
+				{LVM: true, PartitionLabel: "pv1"},
+				{PartitionLabel: "data"},
+				{Size: "10X", PartitionLabel: "data"},
+			},
+		},
+		{
+			RaidCardHardwareID: "raid_card_uuid",
+			DiskHardwareIDs:    []string{"disk1_uuid", "disk2_uuid", "disk1_uuid"},
+			RaidLevel:          10,
+			Partitions: []servers.CreateOptsPartition{
+				{PartitionLabel: "plain"},
+			},
+		},
+	},
+	LVMVolumeGroups: []servers.CreateOptsLVMVolumeGroup{
+		{
+			VGLabel:                       "VG_root",
+			PhysicalVolumePartitionLabels: []string{"pv1", "plain", "missing"},
+			LogicalVolumes: []servers.CreateOptsLogicalVolume{
+				{LVLabel: "LV_root", Size: "20G"},
+			},
+		},
+	},
+	Filesystems: []servers.CreateOptsFilesystem{
+		{Label: "LV_root", FSType: "xfs", MountPoint: "/"},
+		{Label: "pv1", FSType: "xfs", MountPoint: "/srv"},
+		{Label: "plain", FSType: "xfs", MountPoint: "/"},
+		{Label: "unknown", FSType: "xfs", MountPoint: "var"},
+		{Label: "data", FSType: "swap", MountPoint: "/swap"},
+	},
+}
@@ -0,0 +1,181 @@
+package testing
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/disklayout"
+	"github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/servers"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+func TestBuildLayout(t *testing.T) {
+	layout, err := disklayout.NewBuilder().
+		PrimaryRaidArray().
+		LVMPartition("primary-part1", "").
+		Partition("var", "100G").
+		RaidArray("raid_card_uuid", 10, "disk1_uuid", "disk2_uuid", "disk3_uuid", "disk4_uuid").
+		LVMPartition("secondary-part1", "").
+		VolumeGroup("VG_root", "primary-part1", "secondary-part1").
+		LogicalVolume("LV_root", "300G").
+		LogicalVolume("LV_swap", "2G").
+		Filesystem("LV_root", "xfs", "/").
+		Filesystem("var", "xfs", "/var").
+		Swap("LV_swap").
+		Build()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedLayout, *layout)
+
+	createOpts := servers.CreateOpts{Name: "server-test-1"}
+	layout.ApplyTo(&createOpts)
+	th.CheckDeepEquals(t, expectedLayout, disklayout.FromCreateOpts(createOpts))
+}
+
+func TestBuildLayoutMisuse(t *testing.T) {
+	_, err := disklayout.NewBuilder().Partition("var", "100G").PrimaryRaidArray().Build()
+	if _, ok := err.(eclcloud.ErrInvalidInput); !ok {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+
+	_, err = disklayout.NewBuilder().LogicalVolume("LV_root", "10G").Build()
+	if _, ok := err.(eclcloud.ErrInvalidInput); !ok {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestValidateEmptyLayout(t *testing.T) {
+	th.AssertNoErr(t, disklayout.Layout{}.Validate())
+}
+
+func TestValidateInvalidLayout(t *testing.T) {
+	err := invalidLayout.Validate()
+	layoutErr, ok := err.(disklayout.ErrInvalidLayout)
+	if !ok {
+		t.Fatalf("Expected ErrInvalidLayout, got %v", err)
+	}
+
+	expected := []error{
+		disklayout.ErrInvalidRaidArray{Path: "RaidArrays[0]", Info: "the primary storage cannot have a RAID card, disks or RAID level"},
+		disklayout.ErrDuplicateDisk{Path: "RaidArrays[1].DiskHardwareIDs[2]", HardwareID: "disk1_uuid"},
+		disklayout.ErrInvalidRaidLevel{Path: "RaidArrays[1].RaidLevel", RaidLevel: 10, Disks: 3},
+		disklayout.ErrInvalidSize{Path: "RaidArrays[0].Partitions[1].Size"},
+		disklayout.ErrDuplicateLabel{Path: "RaidArrays[0].Partitions[2].PartitionLabel", Label: "data"},
+		disklayout.ErrInvalidSize{Path: "RaidArrays[0].Partitions[2].Size", Size: "10X"},
+		disklayout.ErrInvalidReference{Path: "LVMVolumeGroups[0].PhysicalVolumePartitionLabels[1]", Label: "plain", Info: "is not an LVM partition"},
+		disklayout.ErrUnknownLabel{Path: "LVMVolumeGroups[0].PhysicalVolumePartitionLabels[2]", Label: "missing"},
+		disklayout.ErrInvalidReference{Path: "Filesystems[1].Label", Label: "pv1", Info: "is an LVM partition, which cannot hold a filesystem"},
+		disklayout.ErrDuplicateMountPoint{Path: "Filesystems[2].MountPoint", MountPoint: "/"},
+		disklayout.ErrUnknownLabel{Path: "Filesystems[3].Label", Label: "unknown"},
+		disklayout.ErrInvalidMountPoint{Path: "Filesystems[3].MountPoint", MountPoint: "var", Info: "must be an absolute, clean path"},
+		disklayout.ErrInvalidMountPoint{Path: "Filesystems[4].MountPoint", MountPoint: "/swap", Info: "must not be set for swap"},
+	}
+
+	if !reflect.DeepEqual(expected, layoutErr.Errors) {
+		t.Errorf("Expected errors:")
+		for _, e := range expected {
+			t.Errorf("  %v", e)
+		}
+		t.Errorf("Got errors:")
+		for _, e := range layoutErr.Errors {
+			t.Errorf("  %v", e)
+		}
+	}
+}
+
+func TestValidatePrimaryStorageCount(t *testing.T) {
+	layout := disklayout.Layout{
+		RaidArrays: []servers.CreateOptsRaidArray{
+			{RaidCardHardwareID: "raid_card_uuid", DiskHardwareIDs: []string{"disk1_uuid", "disk2_uuid"}, RaidLevel: 1},
+		},
+	}
+
+	err := layout.Validate()
+	layoutErr, ok := err.(disklayout.ErrInvalidLayout)
+	if !ok {
+		t.Fatalf("Expected ErrInvalidLayout, got %v", err)
+	}
+	th.CheckDeepEquals(t, []error{disklayout.ErrPrimaryStorageCount{Count: 0}}, layoutErr.Errors)
+}
+
+func TestValidateRaidLevels(t *testing.T) {
+	disks := func(n int) []string {
+		ids := make([]string, n)
+		for i := range ids {
+			ids[i] = string(rune('a' + i))
+		}
+		return ids
+	}
+
+	cases := []struct {
+		level int
+		disks int
+		valid bool
+	}{
+		{0, 2, false},
+		{1, 2, true},
+		{1, 3, false},
+		{5, 3, true},
+		{5, 2, false},
+		{6, 4, true},
+		{6, 3, false},
+		{10, 6, true},
+		{10, 5, false},
+		{3, 4, false},
+	}
+
+	for _, c := range cases {
+		layout := disklayout.Layout{
+			RaidArrays: []servers.CreateOptsRaidArray{
+				{PrimaryStorage: true},
+				{RaidCardHardwareID: "raid_card_uuid", DiskHardwareIDs: disks(c.disks), RaidLevel: c.level},
+			},
+		}
+		err := layout.Validate()
+		if c.valid && err != nil {
+			t.Errorf("Expected RAID %d with %d disks to be valid, got %v", c.level, c.disks, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("Expected RAID %d with %d disks to be invalid", c.level, c.disks)
+		}
+	}
+}
+
+func TestValidateMissingRaidLevel(t *testing.T) {
+	layout := disklayout.Layout{
+		RaidArrays: []servers.CreateOptsRaidArray{
+			{PrimaryStorage: true},
+			{RaidCardHardwareID: "raid_card_uuid", DiskHardwareIDs: []string{"disk1_uuid", "disk2_uuid"}},
+		},
+	}
+
+	err := layout.Validate()
+	layoutErr, ok := err.(disklayout.ErrInvalidLayout)
+	if !ok {
+		t.Fatalf("Expected ErrInvalidLayout, got %v", err)
+	}
+	expected := []error{
+		disklayout.ErrInvalidRaidArray{Path: "RaidArrays[1].RaidLevel", Info: "a RAID level is required"},
+	}
+	th.CheckDeepEquals(t, expected, layoutErr.Errors)
+}
+
+func TestValidateMissingValues(t *testing.T) {
+	layout := disklayout.Layout{
+		LVMVolumeGroups: []servers.CreateOptsLVMVolumeGroup{
+			{VGLabel: "VG_root"},
+		},
+		Filesystems: []servers.CreateOptsFilesystem{
+			{Label: "LV_root", FSType: "xfs"},
+		},
+	}
+
+	err := layout.Validate()
+	layoutErr, ok := err.(disklayout.ErrInvalidLayout)
+	if !ok {
+		t.Fatalf("Expected ErrInvalidLayout, got %v", err)
+	}
+	th.AssertEquals(t, 3, len(layoutErr.Errors))
+	th.AssertEquals(t, "LVMVolumeGroups[0].PhysicalVolumePartitionLabels: label is required", layoutErr.Errors[0].Error())
+	th.AssertEquals(t, "Filesystems[0].MountPoint: mount point is required", layoutErr.Errors[2].Error())
+}
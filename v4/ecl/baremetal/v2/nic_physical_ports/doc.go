@@ -0,0 +1,59 @@
+/*
+Package nic_physical_ports contains functionality for working with the NIC
+physical ports of ECL Baremetal Server resources, and for attaching ports of
+the Network service to them after a server has been built.
+
+Example to List NIC Physical Ports
+
+	allPages, err := nic_physical_ports.List(client, "server-id").AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allNICs, err := nic_physical_ports.ExtractNICPhysicalPorts(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, nic := range allNICs {
+		fmt.Printf("%+v\n", nic)
+	}
+
+Example to Get a NIC Physical Port
+
+	nic, err := nic_physical_ports.Get(client, "server-id", "nic-id").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Attach a Port to a NIC Physical Port
+
+	attachOpts := nic_physical_ports.AttachOpts{
+		PortID:           "port-id",
+		SegmentationType: nic_physical_ports.SegmentationTypeVLAN,
+		SegmentationID:   100,
+	}
+
+	port, err := nic_physical_ports.Attach(client, networkClient, "server-id", "nic-id", attachOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	err = nic_physical_ports.WaitForAttach(client, "server-id", "nic-id", port.ID, 300)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Detach a Port from a NIC Physical Port
+
+	_, err := nic_physical_ports.Detach(client, networkClient, "server-id", "nic-id", "port-id")
+	if err != nil {
+		panic(err)
+	}
+
+	err = nic_physical_ports.WaitForDetach(client, "server-id", "nic-id", "port-id", 300)
+	if err != nil {
+		panic(err)
+	}
+*/
+package nic_physical_ports
@@ -0,0 +1,46 @@
+package nic_physical_ports
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrPlaneMismatch is the error when the network of a port is not of the same
+// plane as the NIC physical port it is attached to or detached from.
+type ErrPlaneMismatch struct {
+	eclcloud.BaseError
+	NICPhysicalPortID string
+	Plane             string
+	NetworkID         string
+	NetworkPlane      string
+}
+
+func (e ErrPlaneMismatch) Error() string {
+	return fmt.Sprintf("NIC physical port [%s] is on the %s plane, but network [%s] is on the %s plane",
+		e.NICPhysicalPortID, e.Plane, e.NetworkID, e.NetworkPlane)
+}
+
+// ErrPortInUse is the error when a port to attach is already used by another
+// device.
+type ErrPortInUse struct {
+	eclcloud.BaseError
+	PortID   string
+	DeviceID string
+}
+
+func (e ErrPortInUse) Error() string {
+	return fmt.Sprintf("Port [%s] is already in use by device [%s]", e.PortID, e.DeviceID)
+}
+
+// ErrPortNotAttached is the error when a port to detach is not attached to
+// the NIC physical port.
+type ErrPortNotAttached struct {
+	eclcloud.BaseError
+	PortID            string
+	NICPhysicalPortID string
+}
+
+func (e ErrPortNotAttached) Error() string {
+	return fmt.Sprintf("Port [%s] is not attached to NIC physical port [%s]", e.PortID, e.NICPhysicalPortID)
+}
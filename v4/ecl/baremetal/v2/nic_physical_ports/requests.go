@@ -0,0 +1,196 @@
+package nic_physical_ports
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/networks"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// List returns a Pager which allows you to iterate over the NIC physical
+// ports of a baremetal server.
+func List(client *eclcloud.ServiceClient, serverID string) pagination.Pager {
+	return pagination.NewPager(client, listURL(client, serverID), func(r pagination.PageResult) pagination.Page {
+		return NICPhysicalPortPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a NIC physical port of a baremetal server.
+func Get(client *eclcloud.ServiceClient, serverID, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, serverID, id), &r.Body, nil)
+	return
+}
+
+// Planes of NIC physical ports and networks.
+const (
+	PlaneData    = "data"
+	PlaneStorage = "storage"
+)
+
+// Segmentation types of ports attached to NIC physical ports.
+const (
+	SegmentationTypeFlat = "flat"
+	SegmentationTypeVLAN = "vlan"
+)
+
+// DeviceOwner is the device owner of ports attached to NIC physical ports.
+const DeviceOwner = "baremetal:server"
+
+// AttachOpts specifies the port to attach to a NIC physical port.
+type AttachOpts struct {
+	// PortID is the ID of the network port to attach.
+	PortID string
+
+	// SegmentationType is the segmentation type of the port on the NIC,
+	// flat or vlan. The segmentation of the port is kept if it is not set.
+	SegmentationType string
+
+	// SegmentationID is the VLAN ID of the port on the NIC. It is required
+	// for the vlan segmentation type and must not be set otherwise.
+	SegmentationID int
+}
+
+func (opts AttachOpts) validate() error {
+	if opts.PortID == "" {
+		return eclcloud.ErrMissingInput{Argument: "nic_physical_ports.AttachOpts.PortID"}
+	}
+
+	switch opts.SegmentationType {
+	case SegmentationTypeVLAN:
+		if opts.SegmentationID < 1 || opts.SegmentationID > 4094 {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "nic_physical_ports.AttachOpts.SegmentationID"
+			err.Value = opts.SegmentationID
+			err.Info = "SegmentationID must be between 1 and 4094 for the vlan segmentation type"
+			return err
+		}
+	case "", SegmentationTypeFlat:
+		if opts.SegmentationID != 0 {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "nic_physical_ports.AttachOpts.SegmentationID"
+			err.Value = opts.SegmentationID
+			err.Info = "SegmentationID can only be set for the vlan segmentation type"
+			return err
+		}
+	default:
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "nic_physical_ports.AttachOpts.SegmentationType"
+		err.Value = opts.SegmentationType
+		err.Info = fmt.Sprintf("SegmentationType must be %s or %s", SegmentationTypeFlat, SegmentationTypeVLAN)
+		return err
+	}
+
+	return nil
+}
+
+// Attach attaches a port of the Network service, read and updated with
+// networkClient, to the NIC physical port with the given ID of a baremetal
+// server.
+//
+// The network of the port must be on the same plane as the NIC physical
+// port, otherwise an ErrPlaneMismatch is returned. An ErrPortInUse is
+// returned if the port is used by another device. Attaching a port which is
+// already attached to the NIC physical port does nothing. Use WaitForAttach
+// to wait until the port appears in the AttachedPorts of the NIC physical
+// port.
+func Attach(client, networkClient *eclcloud.ServiceClient, serverID, id string, opts AttachOpts) (*ports.Port, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	nic, port, err := getAndCheckPlane(client, networkClient, serverID, id, opts.PortID)
+	if err != nil {
+		return nil, err
+	}
+
+	if port.DeviceID == nic.NetworkPhysicalPortID {
+		return port, nil
+	}
+	if port.DeviceID != "" {
+		return nil, ErrPortInUse{PortID: port.ID, DeviceID: port.DeviceID}
+	}
+
+	deviceID := nic.NetworkPhysicalPortID
+	deviceOwner := DeviceOwner
+	updateOpts := ports.UpdateOpts{
+		DeviceID:    &deviceID,
+		DeviceOwner: &deviceOwner,
+	}
+	if opts.SegmentationType != "" {
+		updateOpts.SegmentationType = &opts.SegmentationType
+		if opts.SegmentationType == SegmentationTypeVLAN {
+			updateOpts.SegmentationID = &opts.SegmentationID
+		}
+	}
+
+	return ports.Update(networkClient, port.ID, updateOpts).Extract()
+}
+
+// Detach detaches a port of the Network service, read and updated with
+// networkClient, from the NIC physical port with the given ID of a baremetal
+// server.
+//
+// The network of the port must be on the same plane as the NIC physical
+// port, otherwise an ErrPlaneMismatch is returned. An ErrPortNotAttached is
+// returned if the port is not attached to the NIC physical port. The
+// segmentation of the port is reset to flat, so that a VLAN set by Attach
+// does not remain on the port. Use WaitForDetach to wait until the port
+// disappears from the AttachedPorts of the NIC physical port.
+func Detach(client, networkClient *eclcloud.ServiceClient, serverID, id, portID string) (*ports.Port, error) {
+	if portID == "" {
+		return nil, eclcloud.ErrMissingInput{Argument: "nic_physical_ports.Detach.portID"}
+	}
+
+	nic, port, err := getAndCheckPlane(client, networkClient, serverID, id, portID)
+	if err != nil {
+		return nil, err
+	}
+
+	if port.DeviceID != nic.NetworkPhysicalPortID {
+		return nil, ErrPortNotAttached{PortID: port.ID, NICPhysicalPortID: nic.ID}
+	}
+
+	empty := ""
+	flat := SegmentationTypeFlat
+	zero := 0
+	updateOpts := ports.UpdateOpts{
+		DeviceID:         &empty,
+		DeviceOwner:      &empty,
+		SegmentationType: &flat,
+		SegmentationID:   &zero,
+	}
+
+	return ports.Update(networkClient, port.ID, updateOpts).Extract()
+}
+
+// getAndCheckPlane retrieves a NIC physical port and a port, and checks that
+// the network of the port is on the plane of the NIC physical port.
+func getAndCheckPlane(client, networkClient *eclcloud.ServiceClient, serverID, id, portID string) (*NICPhysicalPort, *ports.Port, error) {
+	nic, err := Get(client, serverID, id).Extract()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	port, err := ports.Get(networkClient, portID).Extract()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	network, err := networks.Get(networkClient, port.NetworkID).Extract()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if network.Plane != nic.Plane {
+		return nil, nil, ErrPlaneMismatch{
+			NICPhysicalPortID: nic.ID,
+			Plane:             nic.Plane,
+			NetworkID:         network.ID,
+			NetworkPlane:      network.Plane,
+		}
+	}
+
+	return nic, port, nil
+}
@@ -0,0 +1,67 @@
+package nic_physical_ports
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/servers"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// NICPhysicalPort represents a physical NIC port of a baremetal server. It is
+// the same type as the NIC physical ports of a server, so that the results
+// of both packages can be used interchangeably.
+type NICPhysicalPort = servers.NICPhysicalPort
+
+// AttachedPort represents a network port attached to a NIC physical port.
+type AttachedPort = servers.AttachedPort
+
+// FixedIP represents an IP address of an attached port.
+type FixedIP = servers.FixedIP
+
+// attached reports whether the port with the given ID is attached to the
+// NIC.
+func attached(nic *NICPhysicalPort, portID string) bool {
+	for _, p := range nic.AttachedPorts {
+		if p.PortID == portID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetResult represents the result of a Get operation. Call its Extract method
+// to interpret it as a NICPhysicalPort.
+type GetResult struct {
+	eclcloud.Result
+}
+
+// Extract interprets a GetResult as a NICPhysicalPort.
+func (r GetResult) Extract() (*NICPhysicalPort, error) {
+	var s struct {
+		NICPhysicalPort *NICPhysicalPort `json:"nic_physical_port"`
+	}
+	err := r.ExtractInto(&s)
+	return s.NICPhysicalPort, err
+}
+
+// NICPhysicalPortPage stores a single page of all NICPhysicalPort results
+// from a List call. Use the ExtractNICPhysicalPorts function to convert the
+// results to a slice of NICPhysicalPorts.
+type NICPhysicalPortPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a NICPhysicalPortPage is empty.
+func (page NICPhysicalPortPage) IsEmpty() (bool, error) {
+	ports, err := ExtractNICPhysicalPorts(page)
+	return len(ports) == 0, err
+}
+
+// ExtractNICPhysicalPorts interprets a page of results as a slice of
+// NICPhysicalPorts.
+func ExtractNICPhysicalPorts(r pagination.Page) ([]NICPhysicalPort, error) {
+	var s struct {
+		NICPhysicalPorts []NICPhysicalPort `json:"nic_physical_ports"`
+	}
+	err := (r.(NICPhysicalPortPage)).ExtractInto(&s)
+	return s.NICPhysicalPorts, err
+}
@@ -0,0 +1,2 @@
+// Package testing contains nic_physical_ports unit tests
+package testing
@@ -0,0 +1,231 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/nic_physical_ports"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+const (
+	serverID              = "cebf8bb5-74cf-4a53-bca5-b90d4bbe8d79"
+	dataNICID             = "39285bf9-12a7-4567-9b8d-c0a2b2e5e8f1"
+	storageNICID          = "d1c2b0a7-6e1f-4d4e-8c1a-2f0d8e6b7a93"
+	networkPhysicalPortID = "4cfbe3b2-a502-485f-82fa-a0949396e567"
+	portID                = "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730"
+	dataNetworkID         = "8f36b88a-443f-4d97-9751-34d34af9e782"
+	storageNetworkID      = "a033d04b-b1fe-4ff4-a7c7-5f4b6da981d2"
+)
+
+var listResponse = fmt.Sprintf(`
+{
+	"nic_physical_ports": [
+		{
+			"id": "%s",
+			"mac_addr": "0a:31:c1:d5:6d:9c",
+			"network_physical_port_id": "%s",
+			"plane": "data",
+			"attached_ports": [],
+			"hardware_id": "063bdb5f-0bb8-4cba-8b49-d0f4a5b6c1a6"
+		},
+		{
+			"id": "%s",
+			"mac_addr": "0a:31:c1:d5:6d:9d",
+			"network_physical_port_id": "ab38075d-128f-4f3d-a16a-c6426375a380",
+			"plane": "storage",
+			"attached_ports": [],
+			"hardware_id": "ab8c8bd5-9be3-4fd1-89e3-8f6d1aa0ee41"
+		}
+	]
+}
+`, dataNICID, networkPhysicalPortID, storageNICID)
+
+var expectedNICs = []nic_physical_ports.NICPhysicalPort{
+	{
+		ID:                    dataNICID,
+		MacAddr:               "0a:31:c1:d5:6d:9c",
+		NetworkPhysicalPortID: networkPhysicalPortID,
+		Plane:                 "data",
+		AttachedPorts:         []nic_physical_ports.AttachedPort{},
+		HardwareID:            "063bdb5f-0bb8-4cba-8b49-d0f4a5b6c1a6",
+	},
+	{
+		ID:                    storageNICID,
+		MacAddr:               "0a:31:c1:d5:6d:9d",
+		NetworkPhysicalPortID: "ab38075d-128f-4f3d-a16a-c6426375a380",
+		Plane:                 "storage",
+		AttachedPorts:         []nic_physical_ports.AttachedPort{},
+		HardwareID:            "ab8c8bd5-9be3-4fd1-89e3-8f6d1aa0ee41",
+	},
+}
+
+var dataNICResponse = fmt.Sprintf(`
+{
+	"nic_physical_port": {
+		"id": "%s",
+		"mac_addr": "0a:31:c1:d5:6d:9c",
+		"network_physical_port_id": "%s",
+		"plane": "data",
+		"attached_ports": [],
+		"hardware_id": "063bdb5f-0bb8-4cba-8b49-d0f4a5b6c1a6"
+	}
+}
+`, dataNICID, networkPhysicalPortID)
+
+var dataNICAttachedResponse = fmt.Sprintf(`
+{
+	"nic_physical_port": {
+		"id": "%s",
+		"mac_addr": "0a:31:c1:d5:6d:9c",
+		"network_physical_port_id": "%s",
+		"plane": "data",
+		"attached_ports": [
+			{
+				"port_id": "%s",
+				"network_id": "%s",
+				"fixed_ips": [
+					{
+						"subnet_id": "ab49eb24-667f-4a4e-9421-b4d915bff416",
+						"ip_address": "192.168.10.2"
+					}
+				]
+			}
+		],
+		"hardware_id": "063bdb5f-0bb8-4cba-8b49-d0f4a5b6c1a6"
+	}
+}
+`, dataNICID, networkPhysicalPortID, portID, dataNetworkID)
+
+var attachRequest = fmt.Sprintf(`
+{
+	"port": {
+		"device_id": "%s",
+		"device_owner": "baremetal:server",
+		"segmentation_id": 100,
+		"segmentation_type": "vlan"
+	}
+}
+`, networkPhysicalPortID)
+
+const detachRequest = `
+{
+	"port": {
+		"device_id": "",
+		"device_owner": "",
+		"segmentation_id": 0,
+		"segmentation_type": "flat"
+	}
+}
+`
+
+// portResponse returns a port response with the given device ID.
+func portResponse(deviceID string) string {
+	return fmt.Sprintf(`
+{
+	"port": {
+		"admin_state_up": true,
+		"device_id": "%s",
+		"device_owner": "",
+		"fixed_ips": [
+			{
+				"ip_address": "192.168.10.2",
+				"subnet_id": "ab49eb24-667f-4a4e-9421-b4d915bff416"
+			}
+		],
+		"id": "%s",
+		"mac_address": "fa:16:3e:f4:7a:38",
+		"name": "bm-data-port",
+		"network_id": "%s",
+		"segmentation_id": 0,
+		"segmentation_type": "flat",
+		"status": "ACTIVE",
+		"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	}
+}
+`, deviceID, portID, dataNetworkID)
+}
+
+// networkResponse returns a network response with the given ID and plane.
+func networkResponse(id, plane string) string {
+	return fmt.Sprintf(`
+{
+	"network": {
+		"admin_state_up": true,
+		"id": "%s",
+		"name": "bm-network",
+		"plane": "%s",
+		"status": "ACTIVE",
+		"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	}
+}
+`, id, plane)
+}
+
+// HandleNICListSuccessfully configures the test server to respond to a List
+// request.
+func HandleNICListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s/nic_physical_ports", serverID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleNICGet configures the test server to respond to Get requests for the
+// data NIC with the given responses in turn, repeating the last one.
+func HandleNICGet(t *testing.T, responses ...string) {
+	calls := 0
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s/nic_physical_ports/%s", serverID, dataNICID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		response := responses[len(responses)-1]
+		if calls < len(responses) {
+			response = responses[calls]
+		}
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, response)
+	})
+}
+
+// HandlePort configures the test server to respond to Get requests for the
+// port with the given device ID, and to Update requests with the expected
+// body. Update requests are an error if update is empty.
+func HandlePort(t *testing.T, deviceID, update string) {
+	th.Mux.HandleFunc("/v2.0/ports/"+portID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		switch r.Method {
+		case "GET":
+		case "PUT":
+			if update == "" {
+				t.Errorf("Unexpected port update")
+			}
+			th.TestJSONRequest(t, r, update)
+		default:
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, portResponse(deviceID))
+	})
+}
+
+// HandleNetworkGet configures the test server to respond to Get requests for
+// the data network with the given plane.
+func HandleNetworkGet(t *testing.T, plane string) {
+	th.Mux.HandleFunc("/v2.0/networks/"+dataNetworkID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, networkResponse(dataNetworkID, plane))
+	})
+}
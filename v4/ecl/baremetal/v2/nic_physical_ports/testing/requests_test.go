@@ -0,0 +1,140 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/nic_physical_ports"
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/pagination"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestListNICPhysicalPorts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNICListSuccessfully(t)
+
+	count := 0
+	err := nic_physical_ports.List(fakeclient.ServiceClient(), serverID).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := nic_physical_ports.ExtractNICPhysicalPorts(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, expectedNICs, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetNICPhysicalPort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNICGet(t, dataNICAttachedResponse)
+
+	actual, err := nic_physical_ports.Get(fakeclient.ServiceClient(), serverID, dataNICID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "data", actual.Plane)
+	th.AssertEquals(t, 1, len(actual.AttachedPorts))
+	th.AssertEquals(t, portID, actual.AttachedPorts[0].PortID)
+	th.AssertEquals(t, "192.168.10.2", actual.AttachedPorts[0].FixedIPs[0].IPAddress)
+}
+
+func TestAttachPort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNICGet(t, dataNICResponse, dataNICResponse, dataNICAttachedResponse)
+	HandlePort(t, "", attachRequest)
+	HandleNetworkGet(t, nic_physical_ports.PlaneData)
+
+	attachOpts := nic_physical_ports.AttachOpts{
+		PortID:           portID,
+		SegmentationType: nic_physical_ports.SegmentationTypeVLAN,
+		SegmentationID:   100,
+	}
+	_, err := nic_physical_ports.Attach(fakeclient.ServiceClient(), fake.ServiceClient(), serverID, dataNICID, attachOpts)
+	th.AssertNoErr(t, err)
+
+	err = nic_physical_ports.WaitForAttach(fakeclient.ServiceClient(), serverID, dataNICID, portID, 5)
+	th.AssertNoErr(t, err)
+}
+
+func TestAttachPortPlaneMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNICGet(t, dataNICResponse)
+	HandlePort(t, "", "")
+	HandleNetworkGet(t, nic_physical_ports.PlaneStorage)
+
+	_, err := nic_physical_ports.Attach(fakeclient.ServiceClient(), fake.ServiceClient(), serverID, dataNICID, nic_physical_ports.AttachOpts{PortID: portID})
+	mismatch, ok := err.(nic_physical_ports.ErrPlaneMismatch)
+	if !ok {
+		t.Fatalf("Expected ErrPlaneMismatch, got %v", err)
+	}
+	th.AssertEquals(t, "data", mismatch.Plane)
+	th.AssertEquals(t, "storage", mismatch.NetworkPlane)
+}
+
+func TestAttachPortInUse(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNICGet(t, dataNICResponse)
+	HandlePort(t, "ab38075d-128f-4f3d-a16a-c6426375a380", "")
+	HandleNetworkGet(t, nic_physical_ports.PlaneData)
+
+	_, err := nic_physical_ports.Attach(fakeclient.ServiceClient(), fake.ServiceClient(), serverID, dataNICID, nic_physical_ports.AttachOpts{PortID: portID})
+	if _, ok := err.(nic_physical_ports.ErrPortInUse); !ok {
+		t.Fatalf("Expected ErrPortInUse, got %v", err)
+	}
+}
+
+func TestAttachPortInvalidOpts(t *testing.T) {
+	invalid := []nic_physical_ports.AttachOpts{
+		{},
+		{PortID: portID, SegmentationType: nic_physical_ports.SegmentationTypeVLAN},
+		{PortID: portID, SegmentationType: nic_physical_ports.SegmentationTypeVLAN, SegmentationID: 4095},
+		{PortID: portID, SegmentationID: 100},
+		{PortID: portID, SegmentationType: "vxlan"},
+	}
+	for _, opts := range invalid {
+		_, err := nic_physical_ports.Attach(fakeclient.ServiceClient(), fake.ServiceClient(), serverID, dataNICID, opts)
+		switch err.(type) {
+		case eclcloud.ErrMissingInput, eclcloud.ErrInvalidInput:
+		default:
+			t.Errorf("Expected an input error for %+v, got %v", opts, err)
+		}
+	}
+}
+
+func TestDetachPort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNICGet(t, dataNICAttachedResponse, dataNICAttachedResponse, dataNICResponse)
+	HandlePort(t, networkPhysicalPortID, detachRequest)
+	HandleNetworkGet(t, nic_physical_ports.PlaneData)
+
+	_, err := nic_physical_ports.Detach(fakeclient.ServiceClient(), fake.ServiceClient(), serverID, dataNICID, portID)
+	th.AssertNoErr(t, err)
+
+	err = nic_physical_ports.WaitForDetach(fakeclient.ServiceClient(), serverID, dataNICID, portID, 5)
+	th.AssertNoErr(t, err)
+}
+
+func TestDetachPortNotAttached(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNICGet(t, dataNICResponse)
+	HandlePort(t, "", "")
+	HandleNetworkGet(t, nic_physical_ports.PlaneData)
+
+	_, err := nic_physical_ports.Detach(fakeclient.ServiceClient(), fake.ServiceClient(), serverID, dataNICID, portID)
+	if _, ok := err.(nic_physical_ports.ErrPortNotAttached); !ok {
+		t.Fatalf("Expected ErrPortNotAttached, got %v", err)
+	}
+}
+
+func TestDetachPortMissingPortID(t *testing.T) {
+	_, err := nic_physical_ports.Detach(fakeclient.ServiceClient(), fake.ServiceClient(), serverID, dataNICID, "")
+	th.CheckDeepEquals(t, eclcloud.ErrMissingInput{Argument: "nic_physical_ports.Detach.portID"}, err)
+}
@@ -0,0 +1,11 @@
+package nic_physical_ports
+
+import "github.com/nttcom/eclcloud/v4"
+
+func listURL(c *eclcloud.ServiceClient, serverID string) string {
+	return c.ServiceURL("servers", serverID, "nic_physical_ports")
+}
+
+func getURL(c *eclcloud.ServiceClient, serverID, id string) string {
+	return c.ServiceURL("servers", serverID, "nic_physical_ports", id)
+}
@@ -0,0 +1,31 @@
+package nic_physical_ports
+
+import "github.com/nttcom/eclcloud/v4"
+
+// WaitForAttach will continually poll a NIC physical port until the port
+// with the given ID is one of its attached ports. It will do this for at most
+// the number of seconds specified.
+func WaitForAttach(c *eclcloud.ServiceClient, serverID, id, portID string, secs int) error {
+	return eclcloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, serverID, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		return attached(current, portID), nil
+	})
+}
+
+// WaitForDetach will continually poll a NIC physical port until the port
+// with the given ID is no longer one of its attached ports. It will do this
+// for at most the number of seconds specified.
+func WaitForDetach(c *eclcloud.ServiceClient, serverID, id, portID string, secs int) error {
+	return eclcloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, serverID, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		return !attached(current, portID), nil
+	})
+}
@@ -116,5 +116,83 @@ Example to delete server
 		panic(err)
 	}
 
+Example to power off and on a server
+
+	err := servers.Stop(client, "server-id", servers.StopOpts{}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForStatus(client, "server-id", servers.StatusShutoff, 600)
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.Start(client, "server-id", servers.StartOpts{}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to reboot a server
+
+	rebootOpts := servers.RebootOpts{
+		Type: servers.SoftReboot,
+	}
+
+	err := servers.Reboot(client, "server-id", rebootOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to rebuild a server with a new image
+
+	rebuildOpts := servers.RebuildOpts{
+		ImageRef:  "image-id",
+		AdminPass: "aabbccddeeff",
+	}
+
+	server, err := servers.Rebuild(client, "server-id", rebuildOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to boot a server from a recovery ISO
+
+	attachOpts := servers.AttachMediaOpts{
+		ImageRef: "iso-image-id",
+	}
+
+	err := servers.AttachMedia(client, "server-id", attachOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	rebootOpts := servers.RebootOpts{
+		Type:     servers.HardReboot,
+		BootMode: servers.BootModeISO,
+	}
+
+	err = servers.Reboot(client, "server-id", rebootOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	// After the recovery
+	err = servers.DetachMedia(client, "server-id").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to update server metadata
+
+	metadataOpts := servers.MetadataOpts{
+		"env": "production",
+	}
+
+	metadata, err := servers.UpdateMetadata(client, "server-id", metadataOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 */
 package servers
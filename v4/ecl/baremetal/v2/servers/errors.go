@@ -0,0 +1,18 @@
+package servers
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrServerInErrorState is the error when a server reaches ERROR status while
+// waiting for it to reach another status.
+type ErrServerInErrorState struct {
+	eclcloud.BaseError
+	ID string
+}
+
+func (e ErrServerInErrorState) Error() string {
+	return fmt.Sprintf("Server [%s] is in ERROR state", e.ID)
+}
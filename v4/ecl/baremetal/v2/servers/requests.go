@@ -2,6 +2,7 @@ package servers
 
 import (
 	"encoding/base64"
+	"fmt"
 
 	"github.com/nttcom/eclcloud/v4"
 	"github.com/nttcom/eclcloud/v4/pagination"
@@ -184,3 +185,322 @@ func Delete(client *eclcloud.ServiceClient, id string) (r DeleteResult) {
 	_, r.Err = client.Delete(deleteURL(client, id), nil)
 	return
 }
+
+// BootMode is the device a baremetal server boots from.
+type BootMode string
+
+const (
+	// BootModeDisk boots the server from its disks.
+	BootModeDisk BootMode = "DISK"
+
+	// BootModePXE boots the server from the network.
+	BootModePXE BootMode = "PXE"
+
+	// BootModeISO boots the server from the attached virtual media.
+	BootModeISO BootMode = "ISO"
+)
+
+func validateBootMode(argument string, mode BootMode) error {
+	switch mode {
+	case "", BootModeDisk, BootModePXE, BootModeISO:
+		return nil
+	}
+	err := eclcloud.ErrInvalidInput{}
+	err.Argument = argument
+	err.Value = mode
+	err.Info = fmt.Sprintf("BootMode must be one of %s, %s or %s", BootModeDisk, BootModePXE, BootModeISO)
+	return err
+}
+
+// StartOptsBuilder allows extensions to add additional parameters to the
+// Start request.
+type StartOptsBuilder interface {
+	ToServerStartMap() (map[string]interface{}, error)
+}
+
+// StartOpts provides options to the Start request.
+type StartOpts struct {
+	// BootMode is the device to boot from. The server boots from its disks if
+	// it is not set.
+	BootMode BootMode `json:"boot_mode,omitempty"`
+}
+
+// ToServerStartMap builds a body for the Start request.
+func (opts StartOpts) ToServerStartMap() (map[string]interface{}, error) {
+	if err := validateBootMode("servers.StartOpts.BootMode", opts.BootMode); err != nil {
+		return nil, err
+	}
+	return eclcloud.BuildRequestBody(opts, "os-start")
+}
+
+// Start powers on a server which is in SHUTOFF status. The server returns to
+// ACTIVE status once it has been powered on.
+func Start(client *eclcloud.ServiceClient, id string, opts StartOptsBuilder) (r ActionResult) {
+	b, err := opts.ToServerStartMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// StopOptsBuilder allows extensions to add additional parameters to the Stop
+// request.
+type StopOptsBuilder interface {
+	ToServerStopMap() (map[string]interface{}, error)
+}
+
+// StopOpts provides options to the Stop request.
+type StopOpts struct {
+	// ForceShutdown cuts the power of the server instead of asking its
+	// operating system to shut down.
+	ForceShutdown bool `json:"force_shutdown"`
+}
+
+// ToServerStopMap builds a body for the Stop request.
+func (opts StopOpts) ToServerStopMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "os-stop")
+}
+
+// Stop powers off a server which is in ACTIVE status. The server reaches
+// SHUTOFF status once it has been powered off.
+func Stop(client *eclcloud.ServiceClient, id string, opts StopOptsBuilder) (r ActionResult) {
+	b, err := opts.ToServerStopMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// RebootMethod describes the mechanisms by which a server reboot can be
+// requested.
+type RebootMethod string
+
+// These constants determine how a server should be rebooted.
+const (
+	// SoftReboot asks the operating system of the server to restart.
+	SoftReboot RebootMethod = "SOFT"
+
+	// HardReboot power cycles the server.
+	HardReboot RebootMethod = "HARD"
+)
+
+// RebootOptsBuilder allows extensions to add additional parameters to the
+// Reboot request.
+type RebootOptsBuilder interface {
+	ToServerRebootMap() (map[string]interface{}, error)
+}
+
+// RebootOpts provides options to the Reboot request.
+type RebootOpts struct {
+	// Type is the type of reboot to perform on the server.
+	Type RebootMethod `json:"type" required:"true"`
+
+	// BootMode is the device to boot from. The server boots from its disks if
+	// it is not set.
+	BootMode BootMode `json:"boot_mode,omitempty"`
+}
+
+// ToServerRebootMap builds a body for the Reboot request.
+func (opts RebootOpts) ToServerRebootMap() (map[string]interface{}, error) {
+	if opts.Type != SoftReboot && opts.Type != HardReboot {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "servers.RebootOpts.Type"
+		err.Value = opts.Type
+		err.Info = fmt.Sprintf("Type must be %s or %s", SoftReboot, HardReboot)
+		return nil, err
+	}
+	if err := validateBootMode("servers.RebootOpts.BootMode", opts.BootMode); err != nil {
+		return nil, err
+	}
+	return eclcloud.BuildRequestBody(opts, "reboot")
+}
+
+// Reboot requests that a server reboot. The server passes through REBOOT or
+// HARD_REBOOT status and returns to ACTIVE once the reboot completes.
+func Reboot(client *eclcloud.ServiceClient, id string, opts RebootOptsBuilder) (r ActionResult) {
+	b, err := opts.ToServerRebootMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// RebuildOptsBuilder allows extensions to add additional parameters to the
+// Rebuild request.
+type RebuildOptsBuilder interface {
+	ToServerRebuildMap() (map[string]interface{}, error)
+}
+
+// RebuildOpts provides options to the Rebuild request.
+type RebuildOpts struct {
+	// ImageRef is the ID of the image the server is reinstalled with.
+	ImageRef string `json:"imageRef" required:"true"`
+
+	// AdminPass is the new administrative password of the server.
+	AdminPass string `json:"adminPass,omitempty"`
+
+	// Name is the new name of the server.
+	Name string `json:"name,omitempty"`
+
+	// KeyName is the name of the key pair injected into the server.
+	KeyName string `json:"key_name,omitempty"`
+
+	// Metadata replaces the metadata of the server.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// UserData is run by the server when it boots for the first time.
+	UserData []byte `json:"-"`
+}
+
+// ToServerRebuildMap builds a body for the Rebuild request.
+func (opts RebuildOpts) ToServerRebuildMap() (map[string]interface{}, error) {
+	b, err := eclcloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.UserData != nil {
+		var userData string
+		if _, err := base64.StdEncoding.DecodeString(string(opts.UserData)); err != nil {
+			userData = base64.StdEncoding.EncodeToString(opts.UserData)
+		} else {
+			userData = string(opts.UserData)
+		}
+		b["user_data"] = &userData
+	}
+
+	return map[string]interface{}{"rebuild": b}, nil
+}
+
+// Rebuild reinstalls a server with a new image. The disk layout of the server
+// is kept and its data is lost. The server passes through REBUILD status and
+// returns to ACTIVE once it has been reinstalled.
+func Rebuild(client *eclcloud.ServiceClient, id string, opts RebuildOptsBuilder) (r RebuildResult) {
+	b, err := opts.ToServerRebuildMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// AttachMediaOptsBuilder allows extensions to add additional parameters to
+// the AttachMedia request.
+type AttachMediaOptsBuilder interface {
+	ToServerAttachMediaMap() (map[string]interface{}, error)
+}
+
+// AttachMediaOpts provides options to the AttachMedia request.
+type AttachMediaOpts struct {
+	// ImageRef is the ID of the ISO image attached as virtual media.
+	ImageRef string `json:"image" required:"true"`
+}
+
+// ToServerAttachMediaMap builds a body for the AttachMedia request.
+func (opts AttachMediaOpts) ToServerAttachMediaMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "media_attachment")
+}
+
+// AttachMedia attaches an ISO image to a server as virtual media, for
+// example to recover its operating system. Reboot or Start the server with
+// BootModeISO to boot from it.
+func AttachMedia(client *eclcloud.ServiceClient, id string, opts AttachMediaOptsBuilder) (r AttachMediaResult) {
+	b, err := opts.ToServerAttachMediaMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(mediaAttachmentsURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// DetachMedia detaches the virtual media attached to a server.
+func DetachMedia(client *eclcloud.ServiceClient, id string) (r DetachMediaResult) {
+	_, r.Err = client.Delete(mediaAttachmentsURL(client, id), &eclcloud.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+	return
+}
+
+// Metadata requests all the metadata of the given server.
+func Metadata(client *eclcloud.ServiceClient, id string) (r GetMetadataResult) {
+	_, r.Err = client.Get(metadataURL(client, id), &r.Body, nil)
+	return
+}
+
+// ResetMetadataOptsBuilder allows extensions to add additional parameters to
+// the ResetMetadata request.
+type ResetMetadataOptsBuilder interface {
+	ToMetadataResetMap() (map[string]interface{}, error)
+}
+
+// UpdateMetadataOptsBuilder allows extensions to add additional parameters to
+// the UpdateMetadata request.
+type UpdateMetadataOptsBuilder interface {
+	ToMetadataUpdateMap() (map[string]interface{}, error)
+}
+
+// MetadataOpts is a map that contains key-value pairs.
+type MetadataOpts map[string]string
+
+// ToMetadataResetMap builds a body for the ResetMetadata request.
+func (opts MetadataOpts) ToMetadataResetMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"metadata": opts}, nil
+}
+
+// ToMetadataUpdateMap builds a body for the UpdateMetadata request.
+func (opts MetadataOpts) ToMetadataUpdateMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"metadata": opts}, nil
+}
+
+// ResetMetadata replaces all the metadata of the given server with opts.
+// To keep metadata which is not in opts, use UpdateMetadata.
+func ResetMetadata(client *eclcloud.ServiceClient, id string, opts ResetMetadataOptsBuilder) (r ResetMetadataResult) {
+	b, err := opts.ToMetadataResetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(metadataURL(client, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdateMetadata creates or updates the metadata in opts for the given
+// server. Metadata which is not in opts is not affected.
+func UpdateMetadata(client *eclcloud.ServiceClient, id string, opts UpdateMetadataOptsBuilder) (r UpdateMetadataResult) {
+	b, err := opts.ToMetadataUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(metadataURL(client, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteMetadatum deletes the metadata with the given key from the given
+// server.
+func DeleteMetadatum(client *eclcloud.ServiceClient, id, key string) (r DeleteMetadatumResult) {
+	_, r.Err = client.Delete(metadatumURL(client, id, key), nil)
+	return
+}
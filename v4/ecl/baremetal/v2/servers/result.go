@@ -36,6 +36,70 @@ type DeleteResult struct {
 	eclcloud.ErrResult
 }
 
+// RebuildResult represents the result of a rebuild operation. Call its
+// Extract method to interpret it as a Server.
+type RebuildResult struct {
+	commonResult
+}
+
+// ActionResult represents the result of server action operations, like
+// reboot. Call its ExtractErr method to determine if the action succeeded or
+// failed.
+type ActionResult struct {
+	eclcloud.ErrResult
+}
+
+// AttachMediaResult represents the result of an attach media operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type AttachMediaResult struct {
+	eclcloud.ErrResult
+}
+
+// DetachMediaResult represents the result of a detach media operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type DetachMediaResult struct {
+	eclcloud.ErrResult
+}
+
+// MetadataResult contains the result of a call for the metadata of a server.
+// Call its Extract method to interpret it as a map[string]string.
+type MetadataResult struct {
+	eclcloud.Result
+}
+
+// GetMetadataResult contains the result of a Metadata operation. Call its
+// Extract method to interpret it as a map[string]string.
+type GetMetadataResult struct {
+	MetadataResult
+}
+
+// ResetMetadataResult contains the result of a ResetMetadata operation. Call
+// its Extract method to interpret it as a map[string]string.
+type ResetMetadataResult struct {
+	MetadataResult
+}
+
+// UpdateMetadataResult contains the result of an UpdateMetadata operation.
+// Call its Extract method to interpret it as a map[string]string.
+type UpdateMetadataResult struct {
+	MetadataResult
+}
+
+// DeleteMetadatumResult contains the result of a DeleteMetadatum operation.
+// Call its ExtractErr method to determine if the call succeeded or failed.
+type DeleteMetadatumResult struct {
+	eclcloud.ErrResult
+}
+
+// Extract interprets any MetadataResult as metadata.
+func (r MetadataResult) Extract() (map[string]string, error) {
+	var s struct {
+		Metadata map[string]string `json:"metadata"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Metadata, err
+}
+
 // Extract provides access to the individual Server returned by
 // the Get and functions.
 func (r commonResult) Extract() (*Server, error) {
@@ -896,3 +896,87 @@ var expectedServer2 = servers.Server{
 	MediaAttachments: []map[string]interface{}{},
 	Personality:      []servers.Personality(nil),
 }
+
+const serverID = "cebf8bb5-74cf-4a53-bca5-b90d4bbe8d79"
+
+const startRequest = `
+{
+	"os-start": {
+		"boot_mode": "ISO"
+	}
+}
+`
+
+const stopRequest = `
+{
+	"os-stop": {
+		"force_shutdown": true
+	}
+}
+`
+
+const rebootRequest = `
+{
+	"reboot": {
+		"type": "HARD",
+		"boot_mode": "PXE"
+	}
+}
+`
+
+const rebuildRequest = `
+{
+	"rebuild": {
+		"imageRef": "b5660a6e-4b46-4be3-9707-6b47221b454f",
+		"adminPass": "aabbccddeeff",
+		"metadata": {
+			"foo": "bar"
+		}
+	}
+}
+`
+
+const attachMediaRequest = `
+{
+	"media_attachment": {
+		"image": "3339fd5f-ec06-4ef8-9337-c1c70218a748"
+	}
+}
+`
+
+const metadataRequest = `
+{
+	"metadata": {
+		"foo": "baz",
+		"env": "production"
+	}
+}
+`
+
+const metadataResponse = `
+{
+	"metadata": {
+		"foo": "baz",
+		"env": "production"
+	}
+}
+`
+
+var expectedMetadata = map[string]string{
+	"foo": "baz",
+	"env": "production",
+}
+
+// serverStatusResponse returns a minimal server response with the given
+// status.
+func serverStatusResponse(status string) string {
+	return fmt.Sprintf(`
+{
+	"server": {
+		"id": "%s",
+		"name": "server-test-1",
+		"status": "%s"
+	}
+}
+`, serverID, status)
+}
@@ -174,3 +174,194 @@ func TestDeleteServer(t *testing.T) {
 	res := servers.Delete(fakeclient.ServiceClient(), "cebf8bb5-74cf-4a53-bca5-b90d4bbe8d79")
 	th.AssertNoErr(t, res.Err)
 }
+
+// handleServerAction configures the test server to expect an action request
+// with the given body and to respond with the given status code and body.
+func handleServerAction(t *testing.T, request string, status int, response string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s/action", serverID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, request)
+
+		if response != "" {
+			w.Header().Add("Content-Type", "application/json")
+		}
+		w.WriteHeader(status)
+		fmt.Fprint(w, response)
+	})
+}
+
+func TestStartServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleServerAction(t, startRequest, http.StatusAccepted, "")
+
+	err := servers.Start(fakeclient.ServiceClient(), serverID, servers.StartOpts{BootMode: servers.BootModeISO}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestStopServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleServerAction(t, stopRequest, http.StatusAccepted, "")
+
+	err := servers.Stop(fakeclient.ServiceClient(), serverID, servers.StopOpts{ForceShutdown: true}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRebootServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleServerAction(t, rebootRequest, http.StatusAccepted, "")
+
+	rebootOpts := servers.RebootOpts{
+		Type:     servers.HardReboot,
+		BootMode: servers.BootModePXE,
+	}
+	err := servers.Reboot(fakeclient.ServiceClient(), serverID, rebootOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRebootServerInvalidOpts(t *testing.T) {
+	invalid := []servers.RebootOpts{
+		{},
+		{Type: "POWER_CYCLE"},
+		{Type: servers.SoftReboot, BootMode: "USB"},
+	}
+	for _, opts := range invalid {
+		err := servers.Reboot(fakeclient.ServiceClient(), serverID, opts).ExtractErr()
+		if err == nil {
+			t.Errorf("Expected an error for %+v", opts)
+		}
+	}
+}
+
+func TestRebuildServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleServerAction(t, rebuildRequest, http.StatusAccepted, serverStatusResponse("REBUILD"))
+
+	rebuildOpts := servers.RebuildOpts{
+		ImageRef:  "b5660a6e-4b46-4be3-9707-6b47221b454f",
+		AdminPass: "aabbccddeeff",
+		Metadata: map[string]string{
+			"foo": "bar",
+		},
+	}
+	server, err := servers.Rebuild(fakeclient.ServiceClient(), serverID, rebuildOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "REBUILD", server.Status)
+}
+
+func TestAttachAndDetachMedia(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s/media_attachments", serverID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, attachMediaRequest)
+			w.WriteHeader(http.StatusAccepted)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+	})
+
+	attachOpts := servers.AttachMediaOpts{ImageRef: "3339fd5f-ec06-4ef8-9337-c1c70218a748"}
+	err := servers.AttachMedia(fakeclient.ServiceClient(), serverID, attachOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = servers.DetachMedia(fakeclient.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestServerMetadata(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s/metadata", serverID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		switch r.Method {
+		case "GET":
+		case "POST", "PUT":
+			th.TestJSONRequest(t, r, metadataRequest)
+		default:
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, metadataResponse)
+	})
+
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s/metadata/foo", serverID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := fakeclient.ServiceClient()
+	opts := servers.MetadataOpts{"foo": "baz", "env": "production"}
+
+	actual, err := servers.Metadata(client, serverID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedMetadata, actual)
+
+	actual, err = servers.UpdateMetadata(client, serverID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedMetadata, actual)
+
+	actual, err = servers.ResetMetadata(client, serverID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedMetadata, actual)
+
+	err = servers.DeleteMetadatum(client, serverID, "foo").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := 0
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s", serverID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		status := "REBOOT"
+		if calls > 0 {
+			status = servers.StatusActive
+		}
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, serverStatusResponse(status))
+	})
+
+	err := servers.WaitForStatus(fakeclient.ServiceClient(), serverID, servers.StatusActive, 5)
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForStatusError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s", serverID), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, serverStatusResponse(servers.StatusError))
+	})
+
+	err := servers.WaitForStatus(fakeclient.ServiceClient(), serverID, servers.StatusShutoff, 5)
+	if _, ok := err.(servers.ErrServerInErrorState); !ok {
+		t.Fatalf("Expected ErrServerInErrorState, got %v", err)
+	}
+}
@@ -19,3 +19,19 @@ func createURL(client *eclcloud.ServiceClient) string {
 func deleteURL(client *eclcloud.ServiceClient, id string) string {
 	return client.ServiceURL("servers", id)
 }
+
+func actionURL(client *eclcloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+func mediaAttachmentsURL(client *eclcloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "media_attachments")
+}
+
+func metadataURL(client *eclcloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "metadata")
+}
+
+func metadatumURL(client *eclcloud.ServiceClient, id, key string) string {
+	return client.ServiceURL("servers", id, "metadata", key)
+}
@@ -0,0 +1,34 @@
+package servers
+
+import "github.com/nttcom/eclcloud/v4"
+
+// Statuses which a server reaches at the end of an action.
+const (
+	StatusActive  = "ACTIVE"
+	StatusShutoff = "SHUTOFF"
+	StatusError   = "ERROR"
+)
+
+// WaitForStatus will continually poll a server until it successfully
+// transitions to a specified status, such as SHUTOFF after Stop or ACTIVE
+// after Start, Reboot or Rebuild. It stops with an ErrServerInErrorState as
+// soon as the server reaches ERROR status, unless that is the status waited
+// for. It will do this for at most the number of seconds specified.
+func WaitForStatus(c *eclcloud.ServiceClient, id, status string, secs int) error {
+	return eclcloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		if current.Status == StatusError {
+			return false, ErrServerInErrorState{ID: id}
+		}
+
+		return false, nil
+	})
+}
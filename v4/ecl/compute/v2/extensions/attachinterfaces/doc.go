@@ -0,0 +1,81 @@
+/*
+Package attachinterfaces provides the ability to retrieve and manage network
+interfaces through the Enterprise Cloud Compute service, attaching ports or
+networks to running servers and detaching them again.
+
+Example of Listing a Server's Interfaces
+
+	serverID := "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f"
+	allPages, err := attachinterfaces.List(computeClient, serverID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allInterfaces, err := attachinterfaces.ExtractInterfaces(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, iface := range allInterfaces {
+		fmt.Printf("%+v\n", iface)
+	}
+
+Example to Get a Server's Interface
+
+	portID := "0dde1598-b374-474e-986f-5b8dd1df1d4e"
+	serverID := "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f"
+	iface, err := attachinterfaces.Get(computeClient, serverID, portID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Attach a Port to a Server and Wait Until It Is Active
+
+	portID := "0dde1598-b374-474e-986f-5b8dd1df1d4e"
+	serverID := "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f"
+
+	attachOpts := attachinterfaces.CreateOpts{
+		PortID: portID,
+	}
+
+	iface, err := attachinterfaces.Create(computeClient, serverID, attachOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = attachinterfaces.WaitForAttach(networkClient, serverID, iface.PortID, 300)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Attach a Network to a Server
+
+	networkID := "8a5fe506-7e9f-4091-899b-96336909d93c"
+	serverID := "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f"
+
+	attachOpts := attachinterfaces.CreateOpts{
+		NetworkID: networkID,
+		FixedIPs:  []string{"192.168.10.5"},
+	}
+
+	iface, err := attachinterfaces.Create(computeClient, serverID, attachOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Detach an Interface from a Server
+
+	portID := "0dde1598-b374-474e-986f-5b8dd1df1d4e"
+	serverID := "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f"
+
+	err := attachinterfaces.Delete(computeClient, serverID, portID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = attachinterfaces.WaitForDetach(networkClient, serverID, portID, 300)
+	if err != nil {
+		panic(err)
+	}
+*/
+package attachinterfaces
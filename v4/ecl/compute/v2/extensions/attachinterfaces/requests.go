@@ -0,0 +1,97 @@
+package attachinterfaces
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// List makes a request against the Compute API to list the network
+// interfaces of a server.
+func List(client *eclcloud.ServiceClient, serverID string) pagination.Pager {
+	return pagination.NewPager(client, listURL(client, serverID), func(r pagination.PageResult) pagination.Page {
+		return InterfacePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get requests details on a single interface attachment by the server and
+// port IDs.
+func Get(client *eclcloud.ServiceClient, serverID, portID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, serverID, portID), &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAttachInterfacesCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new interface attachment.
+// Either PortID or NetworkID may be given; when neither is, the server is
+// attached to a network chosen by the Compute service.
+type CreateOpts struct {
+	// PortID is the ID of the port for which you want to create an interface.
+	// The NetworkID and PortID parameters are mutually exclusive.
+	PortID string `json:"port_id,omitempty"`
+
+	// NetworkID is the ID of the network for which you want to create an
+	// interface. The NetworkID and PortID parameters are mutually exclusive.
+	NetworkID string `json:"net_id,omitempty"`
+
+	// FixedIPs are the IP addresses requested for the interface on
+	// NetworkID.
+	FixedIPs []string `json:"-"`
+}
+
+// ToAttachInterfacesCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToAttachInterfacesCreateMap() (map[string]interface{}, error) {
+	if opts.PortID != "" && opts.NetworkID != "" {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "attachinterfaces.CreateOpts.PortID/NetworkID"
+		err.Info = "Only one of PortID and NetworkID may be provided"
+		return nil, err
+	}
+	if len(opts.FixedIPs) > 0 && opts.NetworkID == "" {
+		err := eclcloud.ErrMissingInput{}
+		err.Argument = "attachinterfaces.CreateOpts.NetworkID"
+		return nil, err
+	}
+
+	b, err := eclcloud.BuildRequestBody(opts, "interfaceAttachment")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(opts.FixedIPs) > 0 {
+		fixedIPs := make([]map[string]interface{}, len(opts.FixedIPs))
+		for i, ip := range opts.FixedIPs {
+			fixedIPs[i] = map[string]interface{}{"ip_address": ip}
+		}
+		b["interfaceAttachment"].(map[string]interface{})["fixed_ips"] = fixedIPs
+	}
+
+	return b, nil
+}
+
+// Create requests the creation of a new interface attachment on the server.
+func Create(client *eclcloud.ServiceClient, serverID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAttachInterfacesCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, serverID), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete makes a request against the Compute API to detach a single
+// interface from a server. The port is detached asynchronously; use
+// WaitForDetach to wait until it is.
+func Delete(client *eclcloud.ServiceClient, serverID, portID string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, serverID, portID), nil)
+	return
+}
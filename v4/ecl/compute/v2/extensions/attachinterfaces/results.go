@@ -0,0 +1,79 @@
+package attachinterfaces
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+type attachInterfaceResult struct {
+	eclcloud.Result
+}
+
+// Extract interprets any attachInterfaceResult as an Interface, if possible.
+func (r attachInterfaceResult) Extract() (*Interface, error) {
+	var s struct {
+		Interface *Interface `json:"interfaceAttachment"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Interface, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract
+// method to interpret it as an Interface.
+type GetResult struct {
+	attachInterfaceResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as an Interface.
+type CreateResult struct {
+	attachInterfaceResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	eclcloud.ErrResult
+}
+
+// Interface represents a network interface on a server.
+type Interface struct {
+	// PortState is the status of the port, such as ACTIVE or DOWN.
+	PortState string `json:"port_state"`
+
+	// FixedIPs are the IP addresses of the port.
+	FixedIPs []ports.FixedIP `json:"fixed_ips"`
+
+	// PortID is the ID of the port.
+	PortID string `json:"port_id"`
+
+	// NetID is the ID of the network the port belongs to.
+	NetID string `json:"net_id"`
+
+	// MACAddr is the MAC address of the port.
+	MACAddr string `json:"mac_addr"`
+}
+
+// InterfacePage stores a single page of all Interface results from a List
+// call. Use the ExtractInterfaces function to convert the results to a slice
+// of Interfaces.
+type InterfacePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty returns true if an InterfacePage contains no interfaces.
+func (r InterfacePage) IsEmpty() (bool, error) {
+	interfaces, err := ExtractInterfaces(r)
+	return len(interfaces) == 0, err
+}
+
+// ExtractInterfaces interprets the results of a single page from a List()
+// call, producing a slice of Interface structs.
+func ExtractInterfaces(r pagination.Page) ([]Interface, error) {
+	var s struct {
+		Interfaces []Interface `json:"interfaceAttachments"`
+	}
+	err := (r.(InterfacePage)).ExtractInto(&s)
+	return s.Interfaces, err
+}
@@ -0,0 +1,2 @@
+// attachinterfaces unit tests
+package testing
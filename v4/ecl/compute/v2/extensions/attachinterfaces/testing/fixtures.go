@@ -0,0 +1,212 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/attachinterfaces"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+// ServerID is the server the interfaces are attached to.
+const ServerID = "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f"
+
+// PortID is the port of the interface attached in the tests.
+const PortID = "0dde1598-b374-474e-986f-5b8dd1df1d4e"
+
+// ListInterfacesExpected represents an expected response from a
+// ListInterfaces request.
+var ListInterfacesExpected = []attachinterfaces.Interface{
+	{
+		PortState: "ACTIVE",
+		FixedIPs: []ports.FixedIP{
+			{
+				SubnetID:  "d7906db4-a566-4546-b1f4-5c7fa70f0bf3",
+				IPAddress: "10.0.0.7",
+			},
+			{
+				SubnetID:  "45906d64-a548-4276-h1f2-3c7fa73f0bf3",
+				IPAddress: "10.0.0.8",
+			},
+		},
+		PortID:  "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+		NetID:   "8a5fe506-7e9f-4091-899b-96336909d93c",
+		MACAddr: "fa:16:3e:38:2d:80",
+	},
+}
+
+// GetInterfaceExpected represents an expected response from a GetInterface
+// request.
+var GetInterfaceExpected = attachinterfaces.Interface{
+	PortState: "ACTIVE",
+	FixedIPs: []ports.FixedIP{
+		{
+			SubnetID:  "d7906db4-a566-4546-b1f4-5c7fa70f0bf3",
+			IPAddress: "10.0.0.7",
+		},
+		{
+			SubnetID:  "45906d64-a548-4276-h1f2-3c7fa73f0bf3",
+			IPAddress: "10.0.0.8",
+		},
+	},
+	PortID:  "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+	NetID:   "8a5fe506-7e9f-4091-899b-96336909d93c",
+	MACAddr: "fa:16:3e:38:2d:80",
+}
+
+// CreateInterfacesExpected represents an expected response from a
+// CreateInterface request.
+var CreateInterfacesExpected = attachinterfaces.Interface{
+	PortState: "ACTIVE",
+	FixedIPs: []ports.FixedIP{
+		{
+			SubnetID:  "d7906db4-a566-4546-b1f4-5c7fa70f0bf3",
+			IPAddress: "10.0.0.7",
+		},
+	},
+	PortID:  "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+	NetID:   "8a5fe506-7e9f-4091-899b-96336909d93c",
+	MACAddr: "fa:16:3e:38:2d:80",
+}
+
+// HandleInterfaceListSuccessfully sets up the test server to respond to a
+// ListInterfaces request.
+func HandleInterfaceListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+ServerID+"/os-interface", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"interfaceAttachments": [
+				{
+					"port_state":"ACTIVE",
+					"fixed_ips": [
+						{
+							"subnet_id": "d7906db4-a566-4546-b1f4-5c7fa70f0bf3",
+							"ip_address": "10.0.0.7"
+						},
+						{
+							"subnet_id": "45906d64-a548-4276-h1f2-3c7fa73f0bf3",
+							"ip_address": "10.0.0.8"
+						}
+					],
+					"port_id": "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+					"net_id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+					"mac_addr": "fa:16:3e:38:2d:80"
+				}
+			]
+		}`)
+	})
+}
+
+// HandleInterfaceGetSuccessfully sets up the test server to respond to a
+// GetInterface request.
+func HandleInterfaceGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+ServerID+"/os-interface/"+PortID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"interfaceAttachment":
+			{
+				"port_state":"ACTIVE",
+				"fixed_ips": [
+					{
+						"subnet_id": "d7906db4-a566-4546-b1f4-5c7fa70f0bf3",
+						"ip_address": "10.0.0.7"
+					},
+					{
+						"subnet_id": "45906d64-a548-4276-h1f2-3c7fa73f0bf3",
+						"ip_address": "10.0.0.8"
+					}
+				],
+				"port_id": "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+				"net_id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+				"mac_addr": "fa:16:3e:38:2d:80"
+			}
+		}`)
+	})
+}
+
+// HandleInterfaceCreateSuccessfully sets up the test server to respond to a
+// CreateInterface request attaching a network with a fixed IP.
+func HandleInterfaceCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+ServerID+"/os-interface", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"interfaceAttachment": {
+				"net_id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+				"fixed_ips": [
+					{
+						"ip_address": "10.0.0.7"
+					}
+				]
+			}
+		}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"interfaceAttachment":
+			{
+				"port_state":"ACTIVE",
+				"fixed_ips": [
+					{
+						"subnet_id": "d7906db4-a566-4546-b1f4-5c7fa70f0bf3",
+						"ip_address": "10.0.0.7"
+					}
+				],
+				"port_id": "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+				"net_id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+				"mac_addr": "fa:16:3e:38:2d:80"
+			}
+		}`)
+	})
+}
+
+// HandleInterfaceDeleteSuccessfully sets up the test server to respond to a
+// DeleteInterface request.
+func HandleInterfaceDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+ServerID+"/os-interface/"+PortID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandlePortGetSuccessfully sets up the test server to respond to a port Get
+// request with the given device ID and status.
+func HandlePortGetSuccessfully(t *testing.T, deviceID, status string) {
+	th.Mux.HandleFunc("/v2.0/ports/"+PortID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"port": {
+				"id": "%s",
+				"network_id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+				"device_id": "%s",
+				"device_owner": "compute:zone1-groupa",
+				"status": "%s"
+			}
+		}`, PortID, deviceID, status)
+	})
+}
+
+// HandlePortGetNotFound sets up the test server to respond to a port Get
+// request for a deleted port.
+func HandlePortGetNotFound(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/ports/"+PortID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
@@ -0,0 +1,103 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/attachinterfaces"
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/pagination"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestListInterface(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInterfaceListSuccessfully(t)
+
+	expected := ListInterfacesExpected
+	pages := 0
+	err := attachinterfaces.List(client.ServiceClient(), ServerID).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := attachinterfaces.ExtractInterfaces(page)
+		th.AssertNoErr(t, err)
+
+		if len(actual) != 1 {
+			t.Fatalf("Expected 1 interface, got %d", len(actual))
+		}
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, pages)
+}
+
+func TestGetInterface(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInterfaceGetSuccessfully(t)
+
+	actual, err := attachinterfaces.Get(client.ServiceClient(), ServerID, PortID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &GetInterfaceExpected, actual)
+}
+
+func TestCreateInterface(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInterfaceCreateSuccessfully(t)
+
+	actual, err := attachinterfaces.Create(client.ServiceClient(), ServerID, attachinterfaces.CreateOpts{
+		NetworkID: "8a5fe506-7e9f-4091-899b-96336909d93c",
+		FixedIPs:  []string{"10.0.0.7"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &CreateInterfacesExpected, actual)
+}
+
+func TestCreateInterfaceInvalidOpts(t *testing.T) {
+	_, err := attachinterfaces.CreateOpts{
+		PortID:    PortID,
+		NetworkID: "8a5fe506-7e9f-4091-899b-96336909d93c",
+	}.ToAttachInterfacesCreateMap()
+	if err == nil {
+		t.Fatal("expected an error when both PortID and NetworkID are given")
+	}
+
+	_, err = attachinterfaces.CreateOpts{
+		PortID:   PortID,
+		FixedIPs: []string{"10.0.0.7"},
+	}.ToAttachInterfacesCreateMap()
+	if err == nil {
+		t.Fatal("expected an error for FixedIPs without NetworkID")
+	}
+}
+
+func TestDeleteInterface(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInterfaceDeleteSuccessfully(t)
+
+	err := attachinterfaces.Delete(client.ServiceClient(), ServerID, PortID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForAttach(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePortGetSuccessfully(t, ServerID, "ACTIVE")
+
+	err := attachinterfaces.WaitForAttach(fake.ServiceClient(), ServerID, PortID, 5)
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForDetach(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePortGetNotFound(t)
+
+	err := attachinterfaces.WaitForDetach(fake.ServiceClient(), ServerID, PortID, 5)
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,25 @@
+package attachinterfaces
+
+import "github.com/nttcom/eclcloud/v4"
+
+const resourcePath = "os-interface"
+
+func resourceURL(c *eclcloud.ServiceClient, serverID string) string {
+	return c.ServiceURL("servers", serverID, resourcePath)
+}
+
+func listURL(c *eclcloud.ServiceClient, serverID string) string {
+	return resourceURL(c, serverID)
+}
+
+func createURL(c *eclcloud.ServiceClient, serverID string) string {
+	return resourceURL(c, serverID)
+}
+
+func getURL(c *eclcloud.ServiceClient, serverID, portID string) string {
+	return c.ServiceURL("servers", serverID, resourcePath, portID)
+}
+
+func deleteURL(c *eclcloud.ServiceClient, serverID, portID string) string {
+	return getURL(c, serverID, portID)
+}
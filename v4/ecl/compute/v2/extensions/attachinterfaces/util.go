@@ -0,0 +1,50 @@
+package attachinterfaces
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+)
+
+// portStatusActive is the status of a port which is ready for use.
+const portStatusActive = "ACTIVE"
+
+// WaitForAttach will continually poll a port through the Network client c
+// until it is bound to the server and ACTIVE. It will do this for at most the
+// number of seconds specified.
+func WaitForAttach(c *eclcloud.ServiceClient, serverID, portID string, secs int) error {
+	return eclcloud.WaitFor(secs, func() (bool, error) {
+		current, err := ports.Get(c, portID).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.DeviceID == serverID && current.Status == portStatusActive {
+			return true, nil
+		}
+
+		return false, nil
+	})
+}
+
+// WaitForDetach will continually poll a port through the Network client c
+// until it is no longer bound to the server. A port which was created by
+// attaching a network is deleted on detach, so a port which no longer exists
+// counts as detached. It will do this for at most the number of seconds
+// specified.
+func WaitForDetach(c *eclcloud.ServiceClient, serverID, portID string, secs int) error {
+	return eclcloud.WaitFor(secs, func() (bool, error) {
+		current, err := ports.Get(c, portID).Extract()
+		if err != nil {
+			if _, ok := err.(eclcloud.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+
+		if current.DeviceID != serverID {
+			return true, nil
+		}
+
+		return false, nil
+	})
+}
@@ -0,0 +1,50 @@
+/*
+Package consoles provides the ability to retrieve the console output of a
+server and to create remote consoles (noVNC, serial and SPICE) for it through
+the Enterprise Cloud Compute service.
+
+Example to Show the Console Output of a Server
+
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+	showOpts := consoles.ShowOutputOpts{
+		Length: 50,
+	}
+
+	output, err := consoles.ShowOutput(computeClient, serverID, showOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(output)
+
+Example to Collect Diagnostics of a Server in ERROR Status
+
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+	err := servers.WaitForAnyStatus(computeClient, serverID, []string{servers.StatusActive}, 600)
+	if _, ok := err.(servers.ErrServerInErrorState); ok {
+		output, err := consoles.ShowOutput(computeClient, serverID, consoles.ShowOutputOpts{Length: 100}).Extract()
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(output)
+	}
+
+Example to Create a Remote Console
+
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+	createOpts := consoles.CreateOpts{
+		Type: consoles.TypeNoVNC,
+	}
+
+	console, err := consoles.Create(computeClient, serverID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%s console: %s\n", console.Type, console.URL)
+*/
+package consoles
@@ -0,0 +1,108 @@
+package consoles
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+func actionURL(client *eclcloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// ShowOutputOptsBuilder allows extensions to add additional parameters to the
+// ShowOutput request.
+type ShowOutputOptsBuilder interface {
+	ToServerShowOutputMap() (map[string]interface{}, error)
+}
+
+// ShowOutputOpts specifies parameters of the ShowOutput request.
+type ShowOutputOpts struct {
+	// Length is the number of lines to fetch from the end of the console log.
+	// All lines are returned if it is zero.
+	Length int `json:"length,omitempty"`
+}
+
+// ToServerShowOutputMap formats a ShowOutputOpts into a request body.
+func (opts ShowOutputOpts) ToServerShowOutputMap() (map[string]interface{}, error) {
+	if opts.Length < 0 {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "consoles.ShowOutputOpts.Length"
+		err.Value = opts.Length
+		err.Info = fmt.Sprintf("Length must not be negative, got %d", opts.Length)
+		return nil, err
+	}
+	return eclcloud.BuildRequestBody(opts, "os-getConsoleOutput")
+}
+
+// ShowOutput retrieves the console output of a server.
+func ShowOutput(client *eclcloud.ServiceClient, id string, opts ShowOutputOptsBuilder) (r ShowOutputResult) {
+	b, err := opts.ToServerShowOutputMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ConsoleType represents the type of a remote console.
+type ConsoleType string
+
+const (
+	// TypeNoVNC is a VNC console accessible through the noVNC web client.
+	TypeNoVNC ConsoleType = "novnc"
+
+	// TypeSerial is a serial console accessible through a websocket.
+	TypeSerial ConsoleType = "serial"
+
+	// TypeSPICE is a SPICE console accessible through the HTML5 web client.
+	TypeSPICE ConsoleType = "spice-html5"
+)
+
+// actions maps each ConsoleType to the server action creating it.
+var actions = map[ConsoleType]string{
+	TypeNoVNC:  "os-getVNCConsole",
+	TypeSerial: "os-getSerialConsole",
+	TypeSPICE:  "os-getSPICEConsole",
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToRemoteConsoleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters to the Create request.
+type CreateOpts struct {
+	// Type is the type of the remote console.
+	Type ConsoleType `json:"type" required:"true"`
+}
+
+// ToRemoteConsoleCreateMap formats a CreateOpts into a request body.
+func (opts CreateOpts) ToRemoteConsoleCreateMap() (map[string]interface{}, error) {
+	action, ok := actions[opts.Type]
+	if !ok {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "consoles.CreateOpts.Type"
+		err.Value = opts.Type
+		err.Info = fmt.Sprintf("Type must be one of %q, %q or %q", TypeNoVNC, TypeSerial, TypeSPICE)
+		return nil, err
+	}
+	return eclcloud.BuildRequestBody(opts, action)
+}
+
+// Create requests a remote console of the given type for a server.
+func Create(client *eclcloud.ServiceClient, id string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToRemoteConsoleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
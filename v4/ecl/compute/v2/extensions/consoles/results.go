@@ -0,0 +1,51 @@
+package consoles
+
+import (
+	"net/url"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ShowOutputResult is the response from a ShowOutput operation. Call its
+// Extract method to retrieve the console output.
+type ShowOutputResult struct {
+	eclcloud.Result
+}
+
+// Extract interprets a ShowOutputResult as the console output of a server.
+func (r ShowOutputResult) Extract() (string, error) {
+	var s struct {
+		Output string `json:"output"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Output, err
+}
+
+// RemoteConsole represents a remote console of a server.
+type RemoteConsole struct {
+	// Type is the type of the remote console.
+	Type ConsoleType `json:"type"`
+
+	// URL is the URL used to connect to the remote console.
+	URL string `json:"url"`
+}
+
+// ParseURL parses the URL of the remote console.
+func (c RemoteConsole) ParseURL() (*url.URL, error) {
+	return url.Parse(c.URL)
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a RemoteConsole.
+type CreateResult struct {
+	eclcloud.Result
+}
+
+// Extract interprets a CreateResult as a RemoteConsole.
+func (r CreateResult) Extract() (*RemoteConsole, error) {
+	var s struct {
+		Console *RemoteConsole `json:"console"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Console, err
+}
@@ -0,0 +1,2 @@
+// consoles unit tests
+package testing
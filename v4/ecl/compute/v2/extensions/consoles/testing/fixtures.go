@@ -0,0 +1,56 @@
+package testing
+
+// ShowOutputRequest represents a request to show the console output.
+const ShowOutputRequest = `
+{
+  "os-getConsoleOutput": {
+    "length": 50
+  }
+}
+`
+
+// ShowOutputResult represents a raw server response to a ShowOutputRequest.
+const ShowOutputResult = `
+{
+  "output": "[    0.000000] Booting Linux\nLogin: "
+}
+`
+
+// CreateNoVNCRequest represents a request to create a noVNC console.
+const CreateNoVNCRequest = `
+{
+  "os-getVNCConsole": {
+    "type": "novnc"
+  }
+}
+`
+
+// CreateNoVNCResult represents a raw server response to a CreateNoVNCRequest.
+const CreateNoVNCResult = `
+{
+  "console": {
+    "type": "novnc",
+    "url": "https://console.example.com/vnc_auto.html?token=6b4e1a3c"
+  }
+}
+`
+
+// CreateSerialRequest represents a request to create a serial console.
+const CreateSerialRequest = `
+{
+  "os-getSerialConsole": {
+    "type": "serial"
+  }
+}
+`
+
+// CreateSerialResult represents a raw server response to a
+// CreateSerialRequest.
+const CreateSerialResult = `
+{
+  "console": {
+    "type": "serial",
+    "url": "wss://console.example.com/?token=f9906a48"
+  }
+}
+`
@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/consoles"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+const serverID = "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+func handleAction(t *testing.T, request, response string) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, request)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, response)
+	})
+}
+
+func TestShowOutput(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleAction(t, ShowOutputRequest, ShowOutputResult)
+
+	output, err := consoles.ShowOutput(client.ServiceClient(), serverID, consoles.ShowOutputOpts{Length: 50}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "[    0.000000] Booting Linux\nLogin: ", output)
+}
+
+func TestShowOutputNegativeLength(t *testing.T) {
+	_, err := consoles.ShowOutput(client.ServiceClient(), serverID, consoles.ShowOutputOpts{Length: -1}).Extract()
+	if err == nil {
+		t.Fatal("expected an error for a negative length")
+	}
+}
+
+func TestCreateNoVNC(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleAction(t, CreateNoVNCRequest, CreateNoVNCResult)
+
+	console, err := consoles.Create(client.ServiceClient(), serverID, consoles.CreateOpts{Type: consoles.TypeNoVNC}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, consoles.TypeNoVNC, console.Type)
+	th.AssertEquals(t, "https://console.example.com/vnc_auto.html?token=6b4e1a3c", console.URL)
+
+	u, err := console.ParseURL()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "6b4e1a3c", u.Query().Get("token"))
+}
+
+func TestCreateSerial(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleAction(t, CreateSerialRequest, CreateSerialResult)
+
+	console, err := consoles.Create(client.ServiceClient(), serverID, consoles.CreateOpts{Type: consoles.TypeSerial}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, consoles.TypeSerial, console.Type)
+	th.AssertEquals(t, "wss://console.example.com/?token=f9906a48", console.URL)
+}
+
+func TestCreateInvalidType(t *testing.T) {
+	_, err := consoles.Create(client.ServiceClient(), serverID, consoles.CreateOpts{Type: "rdp-html5"}).Extract()
+	if err == nil {
+		t.Fatal("expected an error for an unsupported console type")
+	}
+}
@@ -0,0 +1,18 @@
+/*
+Package limits shows rate and absolute limits of a tenant, together with the
+amount of each resource currently used.
+
+Example to Retrieve Limits for a Tenant
+
+	getOpts := limits.GetOpts{
+		TenantID: "tenant-id",
+	}
+
+	limits, err := limits.Get(computeClient, getOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", limits)
+*/
+package limits
@@ -0,0 +1,2 @@
+// limits unit tests
+package testing
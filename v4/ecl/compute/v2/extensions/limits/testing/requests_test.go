@@ -0,0 +1,23 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/limits"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	getOpts := limits.GetOpts{
+		TenantID: TenantID,
+	}
+
+	actual, err := limits.Get(client.ServiceClient(), getOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &LimitsResult, actual)
+}
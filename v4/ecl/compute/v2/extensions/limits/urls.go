@@ -0,0 +1,9 @@
+package limits
+
+import "github.com/nttcom/eclcloud/v4"
+
+const resourcePath = "limits"
+
+func getURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
@@ -0,0 +1,21 @@
+package lockunlock
+
+import "github.com/nttcom/eclcloud/v4"
+
+func actionURL(client *eclcloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Lock is the operation responsible for locking a Compute server. A locked
+// server cannot be changed or deleted by non-admin users; its status does not
+// change.
+func Lock(client *eclcloud.ServiceClient, id string) (r LockResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"lock": nil}, nil, nil)
+	return
+}
+
+// Unlock is the operation responsible for unlocking a Compute server.
+func Unlock(client *eclcloud.ServiceClient, id string) (r UnlockResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"unlock": nil}, nil, nil)
+	return
+}
@@ -0,0 +1,15 @@
+package lockunlock
+
+import "github.com/nttcom/eclcloud/v4"
+
+// LockResult is the response from a Lock operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type LockResult struct {
+	eclcloud.ErrResult
+}
+
+// UnlockResult is the response from an Unlock operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type UnlockResult struct {
+	eclcloud.ErrResult
+}
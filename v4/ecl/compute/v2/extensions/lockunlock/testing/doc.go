@@ -0,0 +1,2 @@
+// lockunlock unit tests
+package testing
@@ -0,0 +1,29 @@
+/*
+Package pauseunpause provides functionality to pause and unpause servers that have
+been provisioned by the Enterprise Cloud Compute service.
+
+Example to Pause and Unpause a Server
+
+	serverID := "47b6b7b7-568d-40e4-868c-d5c41735532e"
+
+	err := pauseunpause.Pause(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForAnyStatus(computeClient, serverID, []string{servers.StatusPaused}, 300)
+	if err != nil {
+		panic(err)
+	}
+
+	err = pauseunpause.Unpause(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForAnyStatus(computeClient, serverID, []string{servers.StatusActive}, 300)
+	if err != nil {
+		panic(err)
+	}
+*/
+package pauseunpause
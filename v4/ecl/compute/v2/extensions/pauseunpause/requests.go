@@ -0,0 +1,21 @@
+package pauseunpause
+
+import "github.com/nttcom/eclcloud/v4"
+
+func actionURL(client *eclcloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Pause is the operation responsible for pausing a Compute server. The server
+// reaches PAUSED status once its state is held in memory.
+func Pause(client *eclcloud.ServiceClient, id string) (r PauseResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"pause": nil}, nil, nil)
+	return
+}
+
+// Unpause is the operation responsible for unpausing a Compute server. The
+// server returns to ACTIVE status.
+func Unpause(client *eclcloud.ServiceClient, id string) (r UnpauseResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"unpause": nil}, nil, nil)
+	return
+}
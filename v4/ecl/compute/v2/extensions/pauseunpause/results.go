@@ -0,0 +1,15 @@
+package pauseunpause
+
+import "github.com/nttcom/eclcloud/v4"
+
+// PauseResult is the response from a Pause operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type PauseResult struct {
+	eclcloud.ErrResult
+}
+
+// UnpauseResult is the response from an Unpause operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type UnpauseResult struct {
+	eclcloud.ErrResult
+}
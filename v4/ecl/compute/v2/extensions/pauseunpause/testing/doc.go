@@ -0,0 +1,2 @@
+// pauseunpause unit tests
+package testing
@@ -0,0 +1,44 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/pauseunpause"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+const serverID = "645b787e-7fbb-4111-a217-63a2882930f2"
+
+func TestServerPause(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := "/servers/" + serverID + "/action"
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"pause": null}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := pauseunpause.Pause(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestServerUnpause(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := "/servers/" + serverID + "/action"
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"unpause": null}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := pauseunpause.Unpause(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,35 @@
+/*
+Package quotasets enables retrieving and managing Compute quotas.
+
+Example to Get a Quota Set
+
+	quotaset, err := quotasets.Get(computeClient, "tenant-id").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaset)
+
+Example to Get the Detailed Usage of a Quota Set
+
+	detail, err := quotasets.GetDetail(computeClient, "tenant-id").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%d of %d cores in use\n", detail.Cores.InUse, detail.Cores.Limit)
+
+Example to Update a Quota Set
+
+	updateOpts := quotasets.UpdateOpts{
+		Cores: eclcloud.IntToPointer(100),
+	}
+
+	quotaset, err := quotasets.Update(computeClient, "tenant-id", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaset)
+*/
+package quotasets
@@ -0,0 +1,98 @@
+package quotasets
+
+import "github.com/nttcom/eclcloud/v4"
+
+// Get returns public data about a previously created QuotaSet.
+func Get(client *eclcloud.ServiceClient, tenantID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, tenantID), &r.Body, nil)
+	return
+}
+
+// GetDetail returns detailed public data about a previously created QuotaSet,
+// including the amount of each resource in use.
+func GetDetail(client *eclcloud.ServiceClient, tenantID string) (r GetDetailResult) {
+	_, r.Err = client.Get(getDetailURL(client, tenantID), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToComputeQuotaUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the quotas to update. Quotas left nil are unchanged,
+// and -1 removes the limit of a resource.
+type UpdateOpts struct {
+	// FixedIPs is number of fixed ips alloted this quota_set.
+	FixedIPs *int `json:"fixed_ips,omitempty"`
+
+	// FloatingIPs is number of floating ips alloted this quota_set.
+	FloatingIPs *int `json:"floating_ips,omitempty"`
+
+	// InjectedFileContentBytes is content bytes allowed for each injected file.
+	InjectedFileContentBytes *int `json:"injected_file_content_bytes,omitempty"`
+
+	// InjectedFilePathBytes is allowed bytes for each injected file path.
+	InjectedFilePathBytes *int `json:"injected_file_path_bytes,omitempty"`
+
+	// InjectedFiles is injected files allowed for each project.
+	InjectedFiles *int `json:"injected_files,omitempty"`
+
+	// KeyPairs is number of ssh keypairs.
+	KeyPairs *int `json:"key_pairs,omitempty"`
+
+	// MetadataItems is number of metadata items allowed for each instance.
+	MetadataItems *int `json:"metadata_items,omitempty"`
+
+	// RAM is megabytes allowed for each instance.
+	RAM *int `json:"ram,omitempty"`
+
+	// SecurityGroupRules is rules allowed for each security group.
+	SecurityGroupRules *int `json:"security_group_rules,omitempty"`
+
+	// SecurityGroups security groups allowed for each project.
+	SecurityGroups *int `json:"security_groups,omitempty"`
+
+	// Cores is number of instance cores allowed for each project.
+	Cores *int `json:"cores,omitempty"`
+
+	// Instances is number of instances allowed for each project.
+	Instances *int `json:"instances,omitempty"`
+
+	// ServerGroups is the number of ServerGroups allowed for the project.
+	ServerGroups *int `json:"server_groups,omitempty"`
+
+	// ServerGroupMembers is the number of members for each ServerGroup.
+	ServerGroupMembers *int `json:"server_group_members,omitempty"`
+
+	// Force will update the quotaset even if the quota has already been used
+	// and the reserved quota exceeds the new quota.
+	Force bool `json:"force,omitempty"`
+}
+
+// ToComputeQuotaUpdateMap builds the update options into a serializable
+// format.
+func (opts UpdateOpts) ToComputeQuotaUpdateMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "quota_set")
+}
+
+// Update updates the quotas for the given tenantID and returns the new
+// QuotaSet.
+func Update(client *eclcloud.ServiceClient, tenantID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToComputeQuotaUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, tenantID), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete resets the quotas for the given tenantID back to the defaults.
+func Delete(client *eclcloud.ServiceClient, tenantID string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, tenantID), nil)
+	return
+}
@@ -0,0 +1,21 @@
+package quotasets
+
+import "github.com/nttcom/eclcloud/v4"
+
+const resourcePath = "os-quota-sets"
+
+func getURL(c *eclcloud.ServiceClient, tenantID string) string {
+	return c.ServiceURL(resourcePath, tenantID)
+}
+
+func getDetailURL(c *eclcloud.ServiceClient, tenantID string) string {
+	return c.ServiceURL(resourcePath, tenantID, "detail")
+}
+
+func updateURL(c *eclcloud.ServiceClient, tenantID string) string {
+	return getURL(c, tenantID)
+}
+
+func deleteURL(c *eclcloud.ServiceClient, tenantID string) string {
+	return getURL(c, tenantID)
+}
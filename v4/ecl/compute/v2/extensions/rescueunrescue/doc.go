@@ -0,0 +1,37 @@
+/*
+Package rescueunrescue provides functionality to boot servers that have been
+provisioned by the Enterprise Cloud Compute service into rescue mode, and to
+return them to normal operation.
+
+Example to Rescue and Unrescue a Server
+
+	serverID := "47b6b7b7-568d-40e4-868c-d5c41735532e"
+
+	rescueOpts := rescueunrescue.RescueOpts{
+		AdminPass:      "aUPtawPzE9NU",
+		RescueImageRef: "115e5c5b-72f0-4a0a-9067-60706545248c",
+	}
+
+	adminPass, err := rescueunrescue.Rescue(computeClient, serverID, rescueOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForAnyStatus(computeClient, serverID, []string{servers.StatusRescue}, 300)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Rescue password: %s\n", adminPass)
+
+	err = rescueunrescue.Unrescue(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForAnyStatus(computeClient, serverID, []string{servers.StatusActive}, 300)
+	if err != nil {
+		panic(err)
+	}
+*/
+package rescueunrescue
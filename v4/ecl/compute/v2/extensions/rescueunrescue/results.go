@@ -0,0 +1,24 @@
+package rescueunrescue
+
+import "github.com/nttcom/eclcloud/v4"
+
+// RescueResult is the response from a Rescue operation. Call its Extract
+// method to retrieve the administrative password of the rescued server.
+type RescueResult struct {
+	eclcloud.Result
+}
+
+// Extract interprets a RescueResult as an administrative password.
+func (r RescueResult) Extract() (string, error) {
+	var s struct {
+		AdminPass string `json:"adminPass"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AdminPass, err
+}
+
+// UnrescueResult is the response from an Unrescue operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type UnrescueResult struct {
+	eclcloud.ErrResult
+}
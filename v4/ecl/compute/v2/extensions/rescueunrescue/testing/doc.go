@@ -0,0 +1,2 @@
+// rescueunrescue unit tests
+package testing
@@ -0,0 +1,54 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/rescueunrescue"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+const serverID = "645b787e-7fbb-4111-a217-63a2882930f2"
+
+func TestServerRescue(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := "/servers/" + serverID + "/action"
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, RescueRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, RescueResult)
+	})
+
+	opts := rescueunrescue.RescueOpts{
+		AdminPass:      "aUPtawPzE9NU",
+		RescueImageRef: "115e5c5b-72f0-4a0a-9067-60706545248c",
+	}
+	adminPass, err := rescueunrescue.Rescue(client.ServiceClient(), serverID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "aUPtawPzE9NU", adminPass)
+}
+
+func TestServerUnrescue(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := "/servers/" + serverID + "/action"
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"unrescue": null}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := rescueunrescue.Unrescue(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,129 @@
+package schedulerhints
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/servers"
+)
+
+// SchedulerHints represents a set of scheduling hints that are passed to the
+// Compute scheduler.
+type SchedulerHints struct {
+	// Group specifies a Server Group to place the instance in.
+	Group string
+
+	// DifferentHost will place the instance on a compute node that does not
+	// host the given instances.
+	DifferentHost []string
+
+	// SameHost will place the instance on a compute node that hosts the given
+	// instances.
+	SameHost []string
+
+	// Query is a conditional statement that results in compute nodes able to
+	// host the instance.
+	Query []interface{}
+
+	// BuildNearHostIP specifies a subnet of compute nodes to host the instance.
+	BuildNearHostIP string
+
+	// AdditionalProperies are arbitrary key/values that are not validated.
+	AdditionalProperties map[string]interface{}
+}
+
+// SchedulerHintsBuilder builds the scheduler hints into a serializable format.
+type SchedulerHintsBuilder interface {
+	ToServerSchedulerHintsMap() (map[string]interface{}, error)
+}
+
+// ToServerSchedulerHintsMap builds the scheduler hints into a serializable
+// format.
+func (opts SchedulerHints) ToServerSchedulerHintsMap() (map[string]interface{}, error) {
+	sh := make(map[string]interface{})
+
+	if opts.Group != "" {
+		sh["group"] = opts.Group
+	}
+
+	if len(opts.DifferentHost) > 0 {
+		sh["different_host"] = opts.DifferentHost
+	}
+
+	if len(opts.SameHost) > 0 {
+		sh["same_host"] = opts.SameHost
+	}
+
+	if len(opts.Query) > 0 {
+		if _, ok := opts.Query[0].(string); !ok || len(opts.Query) < 2 {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "schedulerhints.SchedulerHints.Query"
+			err.Value = opts.Query
+			err.Info = "Must be a conditional statement in the format of [op,arg,...]"
+			return nil, err
+		}
+
+		// The query is sent as a JSON encoded string.
+		b, err := json.Marshal(opts.Query)
+		if err != nil {
+			return nil, err
+		}
+		sh["query"] = string(b)
+	}
+
+	if opts.BuildNearHostIP != "" {
+		ip, ipnet, err := net.ParseCIDR(opts.BuildNearHostIP)
+		if err != nil {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "schedulerhints.SchedulerHints.BuildNearHostIP"
+			err.Value = opts.BuildNearHostIP
+			err.Info = fmt.Sprintf("Must be a CIDR such as 192.168.1.1/24, got %q", opts.BuildNearHostIP)
+			return nil, err
+		}
+		prefix, _ := ipnet.Mask.Size()
+		sh["build_near_host_ip"] = ip.String()
+		sh["cidr"] = fmt.Sprintf("/%d", prefix)
+	}
+
+	for k, v := range opts.AdditionalProperties {
+		sh[k] = v
+	}
+
+	return sh, nil
+}
+
+// CreateOptsExt adds a SchedulerHints option to the base CreateOpts.
+type CreateOptsExt struct {
+	servers.CreateOptsBuilder
+
+	// SchedulerHints provides a set of hints to the scheduler.
+	SchedulerHints SchedulerHintsBuilder
+}
+
+// ToServerCreateMap adds the SchedulerHints option to the base server creation
+// options.
+func (opts CreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.SchedulerHints == nil {
+		return base, nil
+	}
+
+	schedulerHints, err := opts.SchedulerHints.ToServerSchedulerHintsMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(schedulerHints) == 0 {
+		return base, nil
+	}
+
+	base["os:scheduler_hints"] = schedulerHints
+
+	return base, nil
+}
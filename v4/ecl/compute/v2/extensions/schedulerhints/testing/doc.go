@@ -0,0 +1,2 @@
+// schedulerhints unit tests
+package testing
@@ -0,0 +1,181 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/bootfromvolume"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/keypairs"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/schedulerhints"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/servers"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+func TestCreateOpts(t *testing.T) {
+	base := servers.CreateOpts{
+		Name:      "createdserver",
+		ImageRef:  "asdfasdfasdf",
+		FlavorRef: "performance1-1",
+	}
+
+	schedulerHints := schedulerhints.SchedulerHints{
+		Group: "101aed42-22d9-4a3e-9ba1-21103b0d1aba",
+		DifferentHost: []string{
+			"a0cf03a5-d921-4877-bb5c-86d26cf818e1",
+			"8c19174f-4220-44f0-824a-cd1eeef10287",
+		},
+		SameHost: []string{
+			"a0cf03a5-d921-4877-bb5c-86d26cf818e1",
+			"8c19174f-4220-44f0-824a-cd1eeef10287",
+		},
+		Query:           []interface{}{"and", []string{"=", "$free_ram_mb", "1024"}},
+		BuildNearHostIP: "192.168.1.1/24",
+		AdditionalProperties: map[string]interface{}{
+			"reservation": "a0cf03a5-d921-4877-bb5c-86d26cf818e1",
+		},
+	}
+
+	ext := schedulerhints.CreateOptsExt{
+		CreateOptsBuilder: base,
+		SchedulerHints:    schedulerHints,
+	}
+
+	expected := `
+		{
+			"server": {
+				"name": "createdserver",
+				"imageRef": "asdfasdfasdf",
+				"flavorRef": "performance1-1"
+			},
+			"os:scheduler_hints": {
+				"group": "101aed42-22d9-4a3e-9ba1-21103b0d1aba",
+				"different_host": [
+					"a0cf03a5-d921-4877-bb5c-86d26cf818e1",
+					"8c19174f-4220-44f0-824a-cd1eeef10287"
+				],
+				"same_host": [
+					"a0cf03a5-d921-4877-bb5c-86d26cf818e1",
+					"8c19174f-4220-44f0-824a-cd1eeef10287"
+				],
+				"query": "[\"and\",[\"=\",\"$free_ram_mb\",\"1024\"]]",
+				"build_near_host_ip": "192.168.1.1",
+				"cidr": "/24",
+				"reservation": "a0cf03a5-d921-4877-bb5c-86d26cf818e1"
+			}
+		}
+	`
+	actual, err := ext.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, expected, actual)
+}
+
+func TestCreateOptsInvalidHints(t *testing.T) {
+	base := servers.CreateOpts{
+		Name:      "createdserver",
+		ImageRef:  "asdfasdfasdf",
+		FlavorRef: "performance1-1",
+	}
+
+	ext := schedulerhints.CreateOptsExt{
+		CreateOptsBuilder: base,
+		SchedulerHints: schedulerhints.SchedulerHints{
+			BuildNearHostIP: "192.168.1.1",
+		},
+	}
+	_, err := ext.ToServerCreateMap()
+	if err == nil {
+		t.Fatal("expected an error for a BuildNearHostIP without prefix length")
+	}
+
+	ext.SchedulerHints = schedulerhints.SchedulerHints{
+		Query: []interface{}{"$free_ram_mb"},
+	}
+	_, err = ext.ToServerCreateMap()
+	if err == nil {
+		t.Fatal("expected an error for an incomplete query")
+	}
+}
+
+func TestCreateOptsWithKeyPair(t *testing.T) {
+	base := servers.CreateOpts{
+		Name:      "createdserver",
+		ImageRef:  "asdfasdfasdf",
+		FlavorRef: "performance1-1",
+	}
+
+	ext := keypairs.CreateOptsExt{
+		CreateOptsBuilder: schedulerhints.CreateOptsExt{
+			CreateOptsBuilder: base,
+			SchedulerHints: schedulerhints.SchedulerHints{
+				Group: "101aed42-22d9-4a3e-9ba1-21103b0d1aba",
+			},
+		},
+		KeyName: "keypair-name",
+	}
+
+	expected := `
+		{
+			"server": {
+				"name": "createdserver",
+				"imageRef": "asdfasdfasdf",
+				"flavorRef": "performance1-1",
+				"key_name": "keypair-name"
+			},
+			"os:scheduler_hints": {
+				"group": "101aed42-22d9-4a3e-9ba1-21103b0d1aba"
+			}
+		}
+	`
+	actual, err := ext.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, expected, actual)
+}
+
+func TestCreateOptsWithBootFromVolume(t *testing.T) {
+	base := servers.CreateOpts{
+		Name:      "createdserver",
+		FlavorRef: "performance1-1",
+	}
+
+	ext := bootfromvolume.CreateOptsExt{
+		CreateOptsBuilder: schedulerhints.CreateOptsExt{
+			CreateOptsBuilder: base,
+			SchedulerHints: schedulerhints.SchedulerHints{
+				Group: "101aed42-22d9-4a3e-9ba1-21103b0d1aba",
+			},
+		},
+		BlockDevice: []bootfromvolume.BlockDevice{
+			{
+				UUID:            "123456",
+				SourceType:      bootfromvolume.SourceImage,
+				DestinationType: bootfromvolume.DestinationVolume,
+				VolumeSize:      10,
+			},
+		},
+	}
+
+	expected := `
+		{
+			"server": {
+				"name": "createdserver",
+				"imageRef": "",
+				"flavorRef": "performance1-1",
+				"block_device_mapping_v2": [
+					{
+						"uuid": "123456",
+						"source_type": "image",
+						"destination_type": "volume",
+						"boot_index": 0,
+						"delete_on_termination": false,
+						"volume_size": 10
+					}
+				]
+			},
+			"os:scheduler_hints": {
+				"group": "101aed42-22d9-4a3e-9ba1-21103b0d1aba"
+			}
+		}
+	`
+	actual, err := ext.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, expected, actual)
+}
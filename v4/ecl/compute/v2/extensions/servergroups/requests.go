@@ -0,0 +1,126 @@
+package servergroups
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// Policies of a server group.
+const (
+	// PolicyAffinity places all members of the group on the same host.
+	PolicyAffinity = "affinity"
+
+	// PolicyAntiAffinity places every member of the group on a different host.
+	PolicyAntiAffinity = "anti-affinity"
+
+	// PolicySoftAffinity places members on the same host where possible.
+	PolicySoftAffinity = "soft-affinity"
+
+	// PolicySoftAntiAffinity places members on different hosts where possible.
+	PolicySoftAntiAffinity = "soft-anti-affinity"
+)
+
+// List returns a Pager that allows you to iterate over a collection of
+// ServerGroups.
+func List(client *eclcloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listURL(client), func(r pagination.PageResult) pagination.Page {
+		return ServerGroupPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToServerGroupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies Server Group creation parameters.
+type CreateOpts struct {
+	// Name is the name of the server group.
+	Name string `json:"name" required:"true"`
+
+	// Policies are the server group policies.
+	// Currently exactly one of the Policy constants must be given.
+	Policies []string `json:"policies" required:"true"`
+}
+
+// ToServerGroupCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToServerGroupCreateMap() (map[string]interface{}, error) {
+	if len(opts.Policies) != 1 {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "servergroups.CreateOpts.Policies"
+		err.Value = opts.Policies
+		err.Info = fmt.Sprintf("Exactly one policy must be given, got %d", len(opts.Policies))
+		return nil, err
+	}
+	switch opts.Policies[0] {
+	case PolicyAffinity, PolicyAntiAffinity, PolicySoftAffinity, PolicySoftAntiAffinity:
+	default:
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "servergroups.CreateOpts.Policies"
+		err.Value = opts.Policies[0]
+		err.Info = fmt.Sprintf("Unknown server group policy %q", opts.Policies[0])
+		return nil, err
+	}
+	return eclcloud.BuildRequestBody(opts, "server_group")
+}
+
+// Create requests the creation of a new Server Group.
+func Create(client *eclcloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToServerGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Get returns data about a previously created Server Group.
+func Get(client *eclcloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// Delete requests the deletion of a previously allocated Server Group.
+func Delete(client *eclcloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
+
+// IDFromName is a convenience function that returns a server group's ID given
+// its name.
+func IDFromName(client *eclcloud.ServiceClient, name string) (string, error) {
+	count := 0
+	id := ""
+
+	allPages, err := List(client).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractServerGroups(allPages)
+	if err != nil {
+		return "", err
+	}
+
+	for _, sg := range all {
+		if sg.Name == name {
+			count++
+			id = sg.ID
+		}
+	}
+
+	switch count {
+	case 0:
+		return "", eclcloud.ErrResourceNotFound{Name: name, ResourceType: "server group"}
+	case 1:
+		return id, nil
+	default:
+		return "", eclcloud.ErrMultipleResourcesFound{Name: name, Count: count, ResourceType: "server group"}
+	}
+}
@@ -0,0 +1,2 @@
+// servergroups unit tests
+package testing
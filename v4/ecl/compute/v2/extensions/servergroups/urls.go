@@ -0,0 +1,25 @@
+package servergroups
+
+import "github.com/nttcom/eclcloud/v4"
+
+const resourcePath = "os-server-groups"
+
+func resourceURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func listURL(c *eclcloud.ServiceClient) string {
+	return resourceURL(c)
+}
+
+func createURL(c *eclcloud.ServiceClient) string {
+	return resourceURL(c)
+}
+
+func getURL(c *eclcloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
+
+func deleteURL(c *eclcloud.ServiceClient, id string) string {
+	return getURL(c, id)
+}
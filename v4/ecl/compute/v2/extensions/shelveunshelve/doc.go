@@ -0,0 +1,37 @@
+/*
+Package shelveunshelve provides functionality to shelve and unshelve servers
+that have been provisioned by the Enterprise Cloud Compute service.
+
+A shelved server keeps its disks but releases its compute resources. It
+reaches SHELVED status, or SHELVED_OFFLOADED status once it has been removed
+from its host.
+
+Example to Shelve and Unshelve a Server
+
+	serverID := "47b6b7b7-568d-40e4-868c-d5c41735532e"
+
+	err := shelveunshelve.Shelve(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForAnyStatus(computeClient, serverID, shelveunshelve.ShelvedStatuses, 600)
+	if err != nil {
+		panic(err)
+	}
+
+	unshelveOpts := shelveunshelve.UnshelveOpts{
+		AvailabilityZone: "zone1-groupa",
+	}
+
+	err = shelveunshelve.Unshelve(computeClient, serverID, unshelveOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForAnyStatus(computeClient, serverID, []string{servers.StatusActive}, 600)
+	if err != nil {
+		panic(err)
+	}
+*/
+package shelveunshelve
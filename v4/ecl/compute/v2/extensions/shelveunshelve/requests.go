@@ -0,0 +1,59 @@
+package shelveunshelve
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/servers"
+)
+
+// ShelvedStatuses are the statuses a server may reach after Shelve.
+var ShelvedStatuses = []string{servers.StatusShelved, servers.StatusShelvedOffloaded}
+
+func actionURL(client *eclcloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Shelve is the operation responsible for shelving a Compute server.
+func Shelve(client *eclcloud.ServiceClient, id string) (r ShelveResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"shelve": nil}, nil, nil)
+	return
+}
+
+// ShelveOffload is the operation responsible for removing a shelved server
+// from its host.
+func ShelveOffload(client *eclcloud.ServiceClient, id string) (r ShelveOffloadResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"shelveOffload": nil}, nil, nil)
+	return
+}
+
+// UnshelveOptsBuilder allows extensions to add additional parameters to the
+// Unshelve request.
+type UnshelveOptsBuilder interface {
+	ToUnshelveMap() (map[string]interface{}, error)
+}
+
+// UnshelveOpts specifies parameters of the Unshelve action.
+type UnshelveOpts struct {
+	// AvailabilityZone sets the availability zone the server is unshelved
+	// into. It may only be set for SHELVED_OFFLOADED servers.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+// ToUnshelveMap builds a request body from UnshelveOpts. Without options the
+// action takes a null body.
+func (opts UnshelveOpts) ToUnshelveMap() (map[string]interface{}, error) {
+	if opts.AvailabilityZone == "" {
+		return map[string]interface{}{"unshelve": nil}, nil
+	}
+	return eclcloud.BuildRequestBody(opts, "unshelve")
+}
+
+// Unshelve is the operation responsible for unshelving a Compute server.
+func Unshelve(client *eclcloud.ServiceClient, id string, opts UnshelveOptsBuilder) (r UnshelveResult) {
+	b, err := opts.ToUnshelveMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	return
+}
@@ -0,0 +1,21 @@
+package shelveunshelve
+
+import "github.com/nttcom/eclcloud/v4"
+
+// ShelveResult is the response from a Shelve operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type ShelveResult struct {
+	eclcloud.ErrResult
+}
+
+// ShelveOffloadResult is the response from a ShelveOffload operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type ShelveOffloadResult struct {
+	eclcloud.ErrResult
+}
+
+// UnshelveResult is the response from an Unshelve operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type UnshelveResult struct {
+	eclcloud.ErrResult
+}
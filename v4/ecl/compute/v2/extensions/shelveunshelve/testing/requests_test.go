@@ -0,0 +1,65 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/shelveunshelve"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+const serverID = "645b787e-7fbb-4111-a217-63a2882930f2"
+
+func handleAction(t *testing.T, body string) {
+	url := "/servers/" + serverID + "/action"
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, body)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func TestServerShelve(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleAction(t, `{"shelve": null}`)
+
+	err := shelveunshelve.Shelve(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestServerShelveOffload(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleAction(t, `{"shelveOffload": null}`)
+
+	err := shelveunshelve.ShelveOffload(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestServerUnshelve(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleAction(t, `{"unshelve": null}`)
+
+	err := shelveunshelve.Unshelve(client.ServiceClient(), serverID, shelveunshelve.UnshelveOpts{}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestServerUnshelveToAvailabilityZone(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleAction(t, `{"unshelve": {"availability_zone": "zone1-groupa"}}`)
+
+	opts := shelveunshelve.UnshelveOpts{
+		AvailabilityZone: "zone1-groupa",
+	}
+	err := shelveunshelve.Unshelve(client.ServiceClient(), serverID, opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
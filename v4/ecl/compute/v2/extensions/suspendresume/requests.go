@@ -0,0 +1,21 @@
+package suspendresume
+
+import "github.com/nttcom/eclcloud/v4"
+
+func actionURL(client *eclcloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Suspend is the operation responsible for suspending a Compute server. The
+// server reaches SUSPENDED status once its state is written to disk.
+func Suspend(client *eclcloud.ServiceClient, id string) (r SuspendResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"suspend": nil}, nil, nil)
+	return
+}
+
+// Resume is the operation responsible for resuming a Compute server. The
+// server returns to ACTIVE status.
+func Resume(client *eclcloud.ServiceClient, id string) (r ResumeResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"resume": nil}, nil, nil)
+	return
+}
@@ -0,0 +1,15 @@
+package suspendresume
+
+import "github.com/nttcom/eclcloud/v4"
+
+// SuspendResult is the response from a Suspend operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type SuspendResult struct {
+	eclcloud.ErrResult
+}
+
+// ResumeResult is the response from a Resume operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type ResumeResult struct {
+	eclcloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// suspendresume unit tests
+package testing
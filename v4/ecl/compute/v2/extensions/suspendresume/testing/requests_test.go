@@ -0,0 +1,44 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/suspendresume"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+const serverID = "645b787e-7fbb-4111-a217-63a2882930f2"
+
+func TestServerSuspend(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := "/servers/" + serverID + "/action"
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"suspend": null}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := suspendresume.Suspend(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestServerResume(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := "/servers/" + serverID + "/action"
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"resume": null}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := suspendresume.Resume(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
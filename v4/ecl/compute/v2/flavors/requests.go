@@ -230,11 +230,11 @@ func Get(client *eclcloud.ServiceClient, id string) (r GetResult) {
 // 	return
 // }
 
-// // ExtraSpecs requests all the extra-specs for the given flavor ID.
-// func ListExtraSpecs(client *eclcloud.ServiceClient, flavorID string) (r ListExtraSpecsResult) {
-// 	_, r.Err = client.Get(extraSpecsListURL(client, flavorID), &r.Body, nil)
-// 	return
-// }
+// ListExtraSpecs requests all the extra-specs for the given flavor ID.
+func ListExtraSpecs(client *eclcloud.ServiceClient, flavorID string) (r ListExtraSpecsResult) {
+	_, r.Err = client.Get(extraSpecsListURL(client, flavorID), &r.Body, nil)
+	return
+}
 
 // func GetExtraSpec(client *eclcloud.ServiceClient, flavorID string, key string) (r GetExtraSpecResult) {
 // 	_, r.Err = client.Get(extraSpecsGetURL(client, flavorID, key), &r.Body, nil)
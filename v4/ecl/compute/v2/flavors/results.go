@@ -192,27 +192,27 @@ func ExtractFlavors(r pagination.Page) ([]Flavor, error) {
 // 	TenantID string `json:"tenant_id"`
 // }
 
-// // Extract interprets any extraSpecsResult as ExtraSpecs, if possible.
-// func (r extraSpecsResult) Extract() (map[string]string, error) {
-// 	var s struct {
-// 		ExtraSpecs map[string]string `json:"extra_specs"`
-// 	}
-// 	err := r.ExtractInto(&s)
-// 	return s.ExtraSpecs, err
-// }
+// Extract interprets any extraSpecsResult as ExtraSpecs, if possible.
+func (r extraSpecsResult) Extract() (map[string]string, error) {
+	var s struct {
+		ExtraSpecs map[string]string `json:"extra_specs"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ExtraSpecs, err
+}
 
-// // extraSpecsResult contains the result of a call for (potentially) multiple
-// // key-value pairs. Call its Extract method to interpret it as a
-// // map[string]interface.
-// type extraSpecsResult struct {
-// 	eclcloud.Result
-// }
+// extraSpecsResult contains the result of a call for (potentially) multiple
+// key-value pairs. Call its Extract method to interpret it as a
+// map[string]interface.
+type extraSpecsResult struct {
+	eclcloud.Result
+}
 
-// // ListExtraSpecsResult contains the result of a Get operation. Call its Extract
-// // method to interpret it as a map[string]interface.
-// type ListExtraSpecsResult struct {
-// 	extraSpecsResult
-// }
+// ListExtraSpecsResult contains the result of a Get operation. Call its Extract
+// method to interpret it as a map[string]interface.
+type ListExtraSpecsResult struct {
+	extraSpecsResult
+}
 
 // // // CreateExtraSpecResult contains the result of a Create operation. Call its
 // // // Extract method to interpret it as a map[string]interface.
@@ -151,6 +151,52 @@ Example to Resize a Server
 		panic(result.Err)
 	}
 
+Example to Confirm a Resize
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	err := servers.WaitForAnyStatus(computeClient, serverID, []string{servers.StatusVerifyResize}, 600)
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.ConfirmResize(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Reboot a Server
+
+	rebootOpts := servers.RebootOpts{
+		Type: servers.SoftReboot,
+	}
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	err := servers.Reboot(computeClient, serverID, rebootOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForAnyStatus(computeClient, serverID, []string{servers.StatusActive}, 300)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Rebuild a Server
+
+	rebuildOpts := servers.RebuildOpts{
+		Name:    "new_name",
+		ImageID: "image-uuid",
+	}
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	server, err := servers.Rebuild(computeClient, serverID, rebuildOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Snapshot a Server
 
 	snapshotOpts := servers.CreateImageOpts{
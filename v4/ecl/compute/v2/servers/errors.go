@@ -69,3 +69,18 @@ type ErrServerNotFound struct {
 func (e ErrServerNotFound) Error() string {
 	return fmt.Sprintf("I couldn't find server [%s]", e.ID)
 }
+
+// ErrServerInErrorState is the error when a server reaches ERROR status while
+// waiting for it to complete an action.
+type ErrServerInErrorState struct {
+	eclcloud.BaseError
+	ID    string
+	Fault Fault
+}
+
+func (e ErrServerInErrorState) Error() string {
+	if e.Fault.Message != "" {
+		return fmt.Sprintf("Server [%s] is in ERROR state: %s", e.ID, e.Fault.Message)
+	}
+	return fmt.Sprintf("Server [%s] is in ERROR state", e.ID)
+}
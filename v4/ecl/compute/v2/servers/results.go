@@ -54,6 +54,12 @@ type DeleteResult struct {
 	eclcloud.ErrResult
 }
 
+// RebuildResult is the response from a Rebuild operation. Call its Extract
+// method to interpret it as a Server.
+type RebuildResult struct {
+	serverResult
+}
+
 // ActionResult represents the result of server action operations, like reboot.
 // Call its ExtractErr method to determine if the action succeeded or failed.
 type ActionResult struct {
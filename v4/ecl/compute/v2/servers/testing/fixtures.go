@@ -281,6 +281,26 @@ const CreateRequest = `
 }
 `
 
+// CreateWithSecurityGroupsRequest provides the input to a Create request
+// which places the server into security groups.
+const CreateWithSecurityGroupsRequest = `
+{
+	"server": {
+		"flavorRef": "1CPU-4GB",
+		"imageRef": "c11a6d55-70e9-4d04-a086-4451f07da0d7",
+		"name": "Test Server1",
+		"security_groups": [
+			{
+				"name": "default"
+			},
+			{
+				"name": "85cc3048-abc3-43cc-89b3-377341426ac5"
+			}
+		]
+	}
+}
+`
+
 const CreateResponse = `
 {
 	"server": {
@@ -429,6 +449,24 @@ var ResizeRequest = `
 }
 `
 
+var RebootRequest = `
+{
+	"reboot": {
+		"type": "HARD"
+	}
+}
+`
+
+var RebuildRequest = `
+{
+	"rebuild": {
+		"adminPass": "swordfish",
+		"imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
+		"name": "Test Server1"
+	}
+}
+`
+
 var CreateImageRequest = `
 {
 	"createImage": {
@@ -689,6 +727,23 @@ func HandleCreateServerSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleCreateServerWithSecurityGroupsSuccessfully creates an HTTP handler
+// at `/servers` on the test handler mux that tests server creation with
+// security groups.
+func HandleCreateServerWithSecurityGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateWithSecurityGroupsRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, CreateResponse)
+	})
+}
+
 // HandleDeleteServerSuccessfully creates an HTTP handler at `/servers` on the
 // test handler mux that tests server deletion.
 func HandleDeleteServerSuccessfully(t *testing.T) {
@@ -834,3 +889,66 @@ func HandleCreateImageSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// HandleRebootServerSuccessfully creates an HTTP handler at `/servers` on the
+// test handler mux that tests server reboot action.
+func HandleRebootServerSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s/action", expectedServer2.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, RebootRequest)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleRebuildServerSuccessfully creates an HTTP handler at `/servers` on the
+// test handler mux that tests server rebuild action.
+func HandleRebuildServerSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s/action", expectedServer2.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, RebuildRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, GetResult)
+	})
+}
+
+// HandleConfirmResizeSuccessfully creates an HTTP handler at `/servers` on the
+// test handler mux that tests confirm resize action.
+func HandleConfirmResizeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s/action", expectedServer2.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"confirmResize": null}`)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleRevertResizeSuccessfully creates an HTTP handler at `/servers` on the
+// test handler mux that tests revert resize action.
+func HandleRevertResizeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s/action", expectedServer2.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"revertResize": null}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleGetServerInErrorState creates an HTTP handler at `/servers` on the
+// test handler mux that returns a server in ERROR state.
+func HandleGetServerInErrorState(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/servers/%s", expectedServer2.ID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"server": {"id": "%s", "status": "ERROR", "fault": {"code": 500, "message": "No valid host was found."}}}`, expectedServer2.ID)
+	})
+}
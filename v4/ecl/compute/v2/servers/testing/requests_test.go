@@ -81,6 +81,26 @@ func TestCreateServer(t *testing.T) {
 	th.AssertEquals(t, "aabbccddeeff", actual.AdminPass)
 }
 
+func TestCreateServerWithSecurityGroups(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateServerWithSecurityGroupsSuccessfully(t)
+
+	createOpts := servers.CreateOpts{
+		Name:      "Test Server1",
+		ImageRef:  "c11a6d55-70e9-4d04-a086-4451f07da0d7",
+		FlavorRef: "1CPU-4GB",
+		SecurityGroups: []string{
+			"default",
+			"85cc3048-abc3-43cc-89b3-377341426ac5",
+		},
+	}
+
+	actual, err := servers.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, expectedServer2.ID, actual.ID)
+}
+
 func TestDeleteServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -182,6 +202,81 @@ func TestResizeServer(t *testing.T) {
 	th.AssertNoErr(t, err)
 }
 
+func TestConfirmResize(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleConfirmResizeSuccessfully(t)
+
+	err := servers.ConfirmResize(client.ServiceClient(), expectedServer2.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRevertResize(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRevertResizeSuccessfully(t)
+
+	err := servers.RevertResize(client.ServiceClient(), expectedServer2.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRebootServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRebootServerSuccessfully(t)
+
+	rebootOpts := servers.RebootOpts{Type: servers.HardReboot}
+
+	err := servers.Reboot(client.ServiceClient(), expectedServer2.ID, rebootOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRebootServerInvalidType(t *testing.T) {
+	rebootOpts := servers.RebootOpts{Type: "WARM"}
+
+	err := servers.Reboot(client.ServiceClient(), expectedServer2.ID, rebootOpts).ExtractErr()
+	if _, ok := err.(servers.ErrInvalidHowParameterProvided); !ok {
+		t.Fatalf("Expected ErrInvalidHowParameterProvided, got %#v", err)
+	}
+}
+
+func TestRebuildServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRebuildServerSuccessfully(t)
+
+	rebuildOpts := servers.RebuildOpts{
+		AdminPass: "swordfish",
+		ImageID:   "f90f6034-2570-4974-8351-6b49732ef2eb",
+		Name:      "Test Server1",
+	}
+
+	actual, err := servers.Rebuild(client.ServiceClient(), expectedServer2.ID, rebuildOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedServer2, *actual)
+}
+
+func TestRebuildServerWithoutImage(t *testing.T) {
+	err := servers.Rebuild(client.ServiceClient(), expectedServer2.ID, servers.RebuildOpts{}).Err
+	if _, ok := err.(servers.ErrNeitherImageIDNorImageNameProvided); !ok {
+		t.Fatalf("Expected ErrNeitherImageIDNorImageNameProvided, got %#v", err)
+	}
+}
+
+func TestWaitForAnyStatusError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetServerInErrorState(t)
+
+	err := servers.WaitForAnyStatus(client.ServiceClient(), expectedServer2.ID, []string{servers.StatusActive}, 10)
+	failure, ok := err.(servers.ErrServerInErrorState)
+	if !ok {
+		t.Fatalf("Expected ErrServerInErrorState, got %#v", err)
+	}
+	th.AssertEquals(t, "No valid host was found.", failure.Fault.Message)
+}
+
 func TestCreateImage(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
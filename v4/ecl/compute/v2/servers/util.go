@@ -19,3 +19,42 @@ func WaitForStatus(c *eclcloud.ServiceClient, id, status string, secs int) error
 		return false, nil
 	})
 }
+
+// Statuses which a server reaches at the end of an action.
+const (
+	StatusActive           = "ACTIVE"
+	StatusShutoff          = "SHUTOFF"
+	StatusPaused           = "PAUSED"
+	StatusSuspended        = "SUSPENDED"
+	StatusShelved          = "SHELVED"
+	StatusShelvedOffloaded = "SHELVED_OFFLOADED"
+	StatusRescue           = "RESCUE"
+	StatusVerifyResize     = "VERIFY_RESIZE"
+	StatusError            = "ERROR"
+)
+
+// WaitForAnyStatus will continually poll a server until it transitions to one
+// of the specified statuses, such as SHELVED or SHELVED_OFFLOADED after a
+// shelve action. Unlike WaitForStatus, it stops with an ErrServerInErrorState
+// as soon as the server reaches ERROR status. It will do this for at most the
+// number of seconds specified.
+func WaitForAnyStatus(c *eclcloud.ServiceClient, id string, statuses []string, secs int) error {
+	return eclcloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		for _, status := range statuses {
+			if current.Status == status {
+				return true, nil
+			}
+		}
+
+		if current.Status == StatusError {
+			return false, ErrServerInErrorState{ID: id, Fault: current.Fault}
+		}
+
+		return false, nil
+	})
+}
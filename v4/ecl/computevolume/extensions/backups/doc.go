@@ -0,0 +1,60 @@
+/*
+Package backups provides information and interaction with volume backups in
+the Enterprise Cloud Block Storage service. A backup is a full or incremental
+copy of a volume which is kept apart from the volume and can be restored to a
+new or an existing volume.
+
+Example to List Backups
+
+	allPages, err := backups.List(client, backups.ListOpts{}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allBackups, err := backups.ExtractBackups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, backup := range allBackups {
+		fmt.Printf("%+v\n", backup)
+	}
+
+Example to Create a Backup and Wait for it
+
+	createOpts := backups.CreateOpts{
+		VolumeID: "volume-id",
+		Name:     "nightly",
+		Force:    true,
+	}
+
+	backup, err := backups.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = backups.WaitForStatus(client, backup.ID, "available", 3600)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Restore a Backup to a New Volume
+
+	restore, err := backups.Restore(client, "backup-id", backups.RestoreOpts{Name: "restored"}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = volumes.WaitForStatus(client, restore.VolumeID, "available", 3600)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Backup
+
+	err := backups.Delete(client, "backup-id").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package backups
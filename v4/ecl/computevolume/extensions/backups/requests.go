@@ -0,0 +1,200 @@
+package backups
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToBackupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains options for creating a Backup. This object is passed to
+// the backups.Create function. For more information about these parameters,
+// see the Backup object.
+type CreateOpts struct {
+	// The ID of the volume to back up
+	VolumeID string `json:"volume_id" required:"true"`
+	// The backup name
+	Name string `json:"name,omitempty"`
+	// The backup description
+	Description string `json:"description,omitempty"`
+	// Container is the container the backup is stored in
+	Container string `json:"container,omitempty"`
+	// Incremental creates an incremental backup on top of the latest backup
+	// of the volume
+	Incremental bool `json:"incremental,omitempty"`
+	// Force allows a backup of a volume which is attached to a server
+	Force bool `json:"force,omitempty"`
+	// SnapshotID is the ID of a snapshot of the volume to back up instead of
+	// the volume itself
+	SnapshotID string `json:"snapshot_id,omitempty"`
+}
+
+// ToBackupCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToBackupCreateMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "backup")
+}
+
+// Create will create a new Backup based on the values in CreateOpts. To
+// extract the Backup object from the response, call the Extract method on the
+// CreateResult. Only ID and Name are set in the created Backup; call Get or
+// WaitForStatus to retrieve the other attributes.
+func Create(client *eclcloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToBackupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Delete will delete the existing Backup with the provided ID.
+func Delete(client *eclcloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
+
+// Get retrieves the Backup with the provided ID. To extract the Backup object
+// from the response, call the Extract method on the GetResult.
+func Get(client *eclcloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToBackupListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Backups. It is passed to the
+// backups.List function.
+type ListOpts struct {
+	// AllTenants will retrieve backups of all tenants/projects.
+	AllTenants bool `q:"all_tenants"`
+
+	// Name will filter by the specified backup name.
+	Name string `q:"name"`
+
+	// Status will filter by the specified status.
+	Status string `q:"status"`
+
+	// VolumeID will filter by the volume the backups were taken of.
+	VolumeID string `q:"volume_id"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToBackupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToBackupListQuery() (string, error) {
+	q, err := eclcloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns Backups optionally limited by the conditions provided in
+// ListOpts.
+func List(client *eclcloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToBackupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// RestoreOptsBuilder allows extensions to add additional parameters to the
+// Restore request.
+type RestoreOptsBuilder interface {
+	ToBackupRestoreMap() (map[string]interface{}, error)
+}
+
+// RestoreOpts contains options for restoring a Backup. If VolumeID is omitted,
+// a new volume is created for the restored data.
+type RestoreOpts struct {
+	// VolumeID is the ID of an existing volume to restore the backup to
+	VolumeID string `json:"volume_id,omitempty"`
+	// Name is the name of the new volume. It is ignored if VolumeID is set.
+	Name string `json:"name,omitempty"`
+}
+
+// ToBackupRestoreMap assembles a request body based on the contents of a
+// RestoreOpts.
+func (opts RestoreOpts) ToBackupRestoreMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "restore")
+}
+
+// Restore will restore the Backup with the provided ID to a volume. To
+// extract the VolumeRestore object from the response, call the Extract method
+// on the RestoreResult.
+func Restore(client *eclcloud.ServiceClient, id string, opts RestoreOptsBuilder) (r RestoreResult) {
+	b, err := opts.ToBackupRestoreMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(restoreURL(client, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// IDFromName is a convienience function that returns a backup's ID given its
+// name.
+func IDFromName(client *eclcloud.ServiceClient, name string) (string, error) {
+	count := 0
+	id := ""
+
+	listOpts := ListOpts{
+		Name: name,
+	}
+
+	pages, err := List(client, listOpts).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractBackups(pages)
+	if err != nil {
+		return "", err
+	}
+
+	for _, s := range all {
+		if s.Name == name {
+			count++
+			id = s.ID
+		}
+	}
+
+	switch count {
+	case 0:
+		return "", eclcloud.ErrResourceNotFound{Name: name, ResourceType: "backup"}
+	case 1:
+		return id, nil
+	default:
+		return "", eclcloud.ErrMultipleResourcesFound{Name: name, Count: count, ResourceType: "backup"}
+	}
+}
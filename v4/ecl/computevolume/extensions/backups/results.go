@@ -0,0 +1,157 @@
+package backups
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// Backup contains all the information associated with an Enterprise Cloud
+// volume backup.
+type Backup struct {
+	// Unique identifier for the backup.
+	ID string `json:"id"`
+	// Current status of the backup.
+	Status string `json:"status"`
+	// Size of the backup in GB.
+	Size int `json:"size"`
+	// The ID of the volume the backup was taken of.
+	VolumeID string `json:"volume_id"`
+	// The ID of the snapshot the backup was taken of, if any.
+	SnapshotID string `json:"snapshot_id"`
+	// The date when this backup was created.
+	CreatedAt time.Time `json:"-"`
+	// The date when this backup was last updated.
+	UpdatedAt time.Time `json:"-"`
+	// The point in time of the data contained in the backup.
+	DataTimestamp time.Time `json:"-"`
+	// Human-readable display name for the backup.
+	Name string `json:"name"`
+	// Human-readable description for the backup.
+	Description string `json:"description"`
+	// AvailabilityZone is which availability zone the backup is in.
+	AvailabilityZone string `json:"availability_zone"`
+	// Container is the container the backup is stored in.
+	Container string `json:"container"`
+	// ObjectCount is the number of objects the backup is stored as.
+	ObjectCount int `json:"object_count"`
+	// IsIncremental indicates whether the backup is incremental.
+	IsIncremental bool `json:"is_incremental"`
+	// HasDependentBackups indicates whether incremental backups depend on
+	// this backup.
+	HasDependentBackups bool `json:"has_dependent_backups"`
+	// FailReason is the reason why the backup failed, if it did.
+	FailReason string `json:"fail_reason"`
+}
+
+func (r *Backup) UnmarshalJSON(b []byte) error {
+	type tmp Backup
+	var s struct {
+		tmp
+		CreatedAt     eclcloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt     eclcloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+		DataTimestamp eclcloud.JSONRFC3339MilliNoZ `json:"data_timestamp"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Backup(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+	r.DataTimestamp = time.Time(s.DataTimestamp)
+
+	return err
+}
+
+// BackupPage is a pagination.pager that is returned from a call to the List
+// function.
+type BackupPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a ListResult contains no Backups.
+func (r BackupPage) IsEmpty() (bool, error) {
+	backups, err := ExtractBackups(r)
+	return len(backups) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r BackupPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []eclcloud.Link `json:"backups_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return eclcloud.ExtractNextURL(s.Links)
+}
+
+// ExtractBackups extracts and returns Backups. It is used while iterating
+// over a backups.List call.
+func ExtractBackups(r pagination.Page) ([]Backup, error) {
+	var s []Backup
+	err := ExtractBackupsInto(r, &s)
+	return s, err
+}
+
+type commonResult struct {
+	eclcloud.Result
+}
+
+// Extract will get the Backup object out of the commonResult object.
+func (r commonResult) Extract() (*Backup, error) {
+	var s Backup
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "backup")
+}
+
+func ExtractBackupsInto(r pagination.Page, v interface{}) error {
+	return r.(BackupPage).Result.ExtractIntoSlicePtr(v, "backups")
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	eclcloud.ErrResult
+}
+
+// VolumeRestore contains the volume a backup is being restored to.
+type VolumeRestore struct {
+	// BackupID is the ID of the backup being restored.
+	BackupID string `json:"backup_id"`
+	// VolumeID is the ID of the volume the backup is restored to.
+	VolumeID string `json:"volume_id"`
+	// VolumeName is the name of the volume the backup is restored to.
+	VolumeName string `json:"volume_name"`
+}
+
+// RestoreResult contains the response body and error from a Restore request.
+type RestoreResult struct {
+	eclcloud.Result
+}
+
+// Extract will get the VolumeRestore object out of the RestoreResult object.
+func (r RestoreResult) Extract() (*VolumeRestore, error) {
+	var s VolumeRestore
+	err := r.ExtractIntoStructPtr(&s, "restore")
+	return &s, err
+}
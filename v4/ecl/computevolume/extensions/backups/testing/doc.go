@@ -0,0 +1,2 @@
+// backups unittest
+package testing
@@ -0,0 +1,151 @@
+package testing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4/ecl/computevolume/extensions/backups"
+)
+
+const idBackup1 = "8a6c5d3f-2e1b-4f0a-9c8d-7b6a5f4e3d2c"
+const idBackup2 = "0f9e8d7c-6b5a-4c3d-8e2f-1a0b9c8d7e6f"
+
+const idVolume1 = "251df9eb-c088-4e71-808b-75a690e8814b"
+const idVolume2 = "7e0b432b-c922-49d7-b85a-28ac88164328"
+
+const nameBackup1 = "backup1"
+
+const createdAt = "2019-02-06T08:06:57.000000"
+
+var timeCreatedAt = time.Date(2019, 2, 6, 8, 6, 57, 0, time.UTC)
+
+var listResponse = fmt.Sprintf(`{
+	"backups": [{
+		"id": "%s",
+		"name": "%s",
+		"description": "nightly",
+		"status": "available",
+		"size": 40,
+		"volume_id": "%s",
+		"snapshot_id": null,
+		"availability_zone": "zone1-groupa",
+		"container": "volumebackups",
+		"object_count": 3,
+		"is_incremental": false,
+		"has_dependent_backups": true,
+		"fail_reason": null,
+		"created_at": "%s",
+		"updated_at": "%s",
+		"data_timestamp": "%s",
+		"links": [{
+			"href": "dummy_self_link",
+			"rel": "self"
+		}]
+	}, {
+		"id": "%s",
+		"name": "backup2",
+		"description": null,
+		"status": "creating",
+		"size": 40,
+		"volume_id": "%s",
+		"snapshot_id": null,
+		"availability_zone": "zone1-groupa",
+		"container": "volumebackups",
+		"object_count": 0,
+		"is_incremental": true,
+		"has_dependent_backups": false,
+		"fail_reason": null,
+		"created_at": "%s",
+		"updated_at": null,
+		"data_timestamp": "%s"
+	}]
+}`,
+	idBackup1, nameBackup1, idVolume1, createdAt, createdAt, createdAt,
+	idBackup2, idVolume1, createdAt, createdAt,
+)
+
+var getResponse = fmt.Sprintf(`{
+	"backup": {
+		"id": "%s",
+		"name": "%s",
+		"description": "nightly",
+		"status": "available",
+		"size": 40,
+		"volume_id": "%s",
+		"snapshot_id": null,
+		"availability_zone": "zone1-groupa",
+		"container": "volumebackups",
+		"object_count": 3,
+		"is_incremental": false,
+		"has_dependent_backups": true,
+		"fail_reason": null,
+		"created_at": "%s",
+		"updated_at": "%s",
+		"data_timestamp": "%s"
+	}
+}`, idBackup1, nameBackup1, idVolume1, createdAt, createdAt, createdAt)
+
+var createRequest = fmt.Sprintf(`{
+	"backup": {
+		"volume_id": "%s",
+		"name": "%s",
+		"description": "nightly",
+		"incremental": true,
+		"force": true
+	}
+}`, idVolume1, nameBackup1)
+
+var createResponse = fmt.Sprintf(`{
+	"backup": {
+		"id": "%s",
+		"name": "%s",
+		"links": [{
+			"href": "dummy_self_link",
+			"rel": "self"
+		}]
+	}
+}`, idBackup1, nameBackup1)
+
+var restoreRequest = fmt.Sprintf(`{
+	"restore": {
+		"volume_id": "%s"
+	}
+}`, idVolume2)
+
+var restoreResponse = fmt.Sprintf(`{
+	"restore": {
+		"backup_id": "%s",
+		"volume_id": "%s",
+		"volume_name": "volume2"
+	}
+}`, idBackup1, idVolume2)
+
+var expectedBackupsSlice = []backups.Backup{
+	{
+		ID:                  idBackup1,
+		Name:                nameBackup1,
+		Description:         "nightly",
+		Status:              "available",
+		Size:                40,
+		VolumeID:            idVolume1,
+		AvailabilityZone:    "zone1-groupa",
+		Container:           "volumebackups",
+		ObjectCount:         3,
+		HasDependentBackups: true,
+		CreatedAt:           timeCreatedAt,
+		UpdatedAt:           timeCreatedAt,
+		DataTimestamp:       timeCreatedAt,
+	},
+	{
+		ID:               idBackup2,
+		Name:             "backup2",
+		Status:           "creating",
+		Size:             40,
+		VolumeID:         idVolume1,
+		AvailabilityZone: "zone1-groupa",
+		Container:        "volumebackups",
+		IsIncremental:    true,
+		CreatedAt:        timeCreatedAt,
+		DataTimestamp:    timeCreatedAt,
+	},
+}
@@ -0,0 +1,158 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/computevolume/extensions/backups"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestListBackupAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, listResponse)
+	})
+
+	allPages, err := backups.List(fakeclient.ServiceClient(), backups.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := backups.ExtractBackups(allPages)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, expectedBackupsSlice, actual)
+}
+
+func TestGetBackup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/backups/%s", idBackup1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, getResponse)
+	})
+
+	b, err := backups.Get(fakeclient.ServiceClient(), idBackup1).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, &expectedBackupsSlice[0], b)
+}
+
+func TestCreateBackup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, createRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+
+		fmt.Fprintf(w, createResponse)
+	})
+
+	options := backups.CreateOpts{
+		VolumeID:    idVolume1,
+		Name:        nameBackup1,
+		Description: "nightly",
+		Incremental: true,
+		Force:       true,
+	}
+	b, err := backups.Create(fakeclient.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, idBackup1, b.ID)
+	th.AssertEquals(t, nameBackup1, b.Name)
+}
+
+func TestRestoreBackup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/backups/%s/restore", idBackup1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, restoreRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+
+		fmt.Fprintf(w, restoreResponse)
+	})
+
+	restore, err := backups.Restore(fakeclient.ServiceClient(), idBackup1, backups.RestoreOpts{VolumeID: idVolume2}).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, &backups.VolumeRestore{
+		BackupID:   idBackup1,
+		VolumeID:   idVolume2,
+		VolumeName: "volume2",
+	}, restore)
+}
+
+func TestDeleteBackup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/backups/%s", idBackup1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	res := backups.Delete(fakeclient.ServiceClient(), idBackup1)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/backups/%s", idBackup1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, getResponse)
+	})
+
+	err := backups.WaitForStatus(fakeclient.ServiceClient(), idBackup1, "available", 5)
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/backups/%s", idBackup1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := backups.WaitForDelete(fakeclient.ServiceClient(), idBackup1, 5)
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,23 @@
+package backups
+
+import "github.com/nttcom/eclcloud/v4"
+
+func createURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL("backups")
+}
+
+func listURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL("backups", "detail")
+}
+
+func deleteURL(c *eclcloud.ServiceClient, id string) string {
+	return c.ServiceURL("backups", id)
+}
+
+func getURL(c *eclcloud.ServiceClient, id string) string {
+	return deleteURL(c, id)
+}
+
+func restoreURL(c *eclcloud.ServiceClient, id string) string {
+	return c.ServiceURL("backups", id, "restore")
+}
@@ -0,0 +1,35 @@
+/*
+Package quotasets enables retrieving and managing Compute Volume quotas.
+
+Example to Get a Quota Set
+
+	quotaset, err := quotasets.Get(volumeClient, "tenant-id").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaset)
+
+Example to Get Quota Set Usage
+
+	quotaset, err := quotasets.GetUsage(volumeClient, "tenant-id").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaset)
+
+Example to Update a Quota Set
+
+	updateOpts := quotasets.UpdateOpts{
+		Volumes: eclcloud.IntToPointer(100),
+	}
+
+	quotaset, err := quotasets.Update(volumeClient, "tenant-id", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaset)
+*/
+package quotasets
@@ -0,0 +1,81 @@
+package quotasets
+
+import "github.com/nttcom/eclcloud/v4"
+
+// Get returns the Compute Volume quota set of a tenant.
+func Get(client *eclcloud.ServiceClient, tenantID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, tenantID), &r.Body, nil)
+	return
+}
+
+// GetDefaults returns the default Compute Volume quota set of a tenant.
+func GetDefaults(client *eclcloud.ServiceClient, tenantID string) (r GetResult) {
+	_, r.Err = client.Get(getDefaultsURL(client, tenantID), &r.Body, nil)
+	return
+}
+
+// GetUsage returns the Compute Volume quota set of a tenant together with the
+// usage of each resource.
+func GetUsage(client *eclcloud.ServiceClient, tenantID string) (r GetUsageResult) {
+	_, r.Err = client.Get(getURL(client, tenantID)+"?usage=true", &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToComputeVolumeQuotaUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains options for setting the quota set of a tenant.
+// Quotas left nil are unchanged, and -1 removes the limit of a resource.
+type UpdateOpts struct {
+	// Volumes is the number of volumes that are allowed for each project.
+	Volumes *int `json:"volumes,omitempty"`
+
+	// Snapshots is the number of snapshots that are allowed for each project.
+	Snapshots *int `json:"snapshots,omitempty"`
+
+	// Gigabytes is the size (GB) of volumes and snapshots that are allowed for
+	// each project.
+	Gigabytes *int `json:"gigabytes,omitempty"`
+
+	// PerVolumeGigabytes is the size (GB) of volumes that are allowed for each
+	// volume.
+	PerVolumeGigabytes *int `json:"per_volume_gigabytes,omitempty"`
+
+	// Backups is the number of backups that are allowed for each project.
+	Backups *int `json:"backups,omitempty"`
+
+	// BackupGigabytes is the size (GB) of backups that are allowed for each
+	// project.
+	BackupGigabytes *int `json:"backup_gigabytes,omitempty"`
+}
+
+// ToComputeVolumeQuotaUpdateMap builds the update options into a
+// serializable format.
+func (opts UpdateOpts) ToComputeVolumeQuotaUpdateMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "quota_set")
+}
+
+// Update updates the quotas for the given tenantID and returns the new
+// QuotaSet.
+func Update(client *eclcloud.ServiceClient, tenantID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToComputeVolumeQuotaUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, tenantID), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete resets the quotas for the given tenantID back to the defaults.
+func Delete(client *eclcloud.ServiceClient, tenantID string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, tenantID), &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,124 @@
+package quotasets
+
+import "github.com/nttcom/eclcloud/v4"
+
+// QuotaSet is a set of operational limits that allow for control of Compute
+// Volume usage. A limit of -1 means the resource is unlimited.
+type QuotaSet struct {
+	// ID is the tenant associated with this QuotaSet.
+	ID string `json:"id"`
+
+	// Volumes is the number of volumes that are allowed for each project.
+	Volumes int `json:"volumes"`
+
+	// Snapshots is the number of snapshots that are allowed for each project.
+	Snapshots int `json:"snapshots"`
+
+	// Gigabytes is the size (GB) of volumes and snapshots that are allowed for
+	// each project.
+	Gigabytes int `json:"gigabytes"`
+
+	// PerVolumeGigabytes is the size (GB) of volumes that are allowed for each
+	// volume.
+	PerVolumeGigabytes int `json:"per_volume_gigabytes"`
+
+	// Backups is the number of backups that are allowed for each project.
+	Backups int `json:"backups"`
+
+	// BackupGigabytes is the size (GB) of backups that are allowed for each
+	// project.
+	BackupGigabytes int `json:"backup_gigabytes"`
+}
+
+// QuotaUsage is a set of details about a single operational limit that
+// allows for control of Compute Volume usage.
+type QuotaUsage struct {
+	// InUse is the current number of provisioned resources of the given type.
+	InUse int `json:"in_use"`
+
+	// Allocated is the current number of resources of a given type allocated
+	// for use.
+	Allocated int `json:"allocated"`
+
+	// Reserved is a transitional state when a claim against quota has been
+	// made but the resource is not yet fully online.
+	Reserved int `json:"reserved"`
+
+	// Limit is the maximum number of a given resource that can be provisioned.
+	// -1 means unlimited.
+	Limit int `json:"limit"`
+}
+
+// QuotaUsageSet represents details of both operational limits of Compute
+// Volume resources and the current usage of those resources.
+type QuotaUsageSet struct {
+	// ID is the tenant ID associated with this QuotaUsageSet.
+	ID string `json:"id"`
+
+	// Volumes is the volume usage information for this project.
+	Volumes QuotaUsage `json:"volumes"`
+
+	// Snapshots is the snapshot usage information for this project.
+	Snapshots QuotaUsage `json:"snapshots"`
+
+	// Gigabytes is the size (GB) usage information of volumes and snapshots
+	// for this project.
+	Gigabytes QuotaUsage `json:"gigabytes"`
+
+	// PerVolumeGigabytes is the size (GB) usage information for each volume.
+	PerVolumeGigabytes QuotaUsage `json:"per_volume_gigabytes"`
+
+	// Backups is the backup usage information for this project.
+	Backups QuotaUsage `json:"backups"`
+
+	// BackupGigabytes is the size (GB) usage of backups for this project.
+	BackupGigabytes QuotaUsage `json:"backup_gigabytes"`
+}
+
+type quotaResult struct {
+	eclcloud.Result
+}
+
+// Extract is a method that attempts to interpret any QuotaSet resource
+// response as a QuotaSet struct.
+func (r quotaResult) Extract() (*QuotaSet, error) {
+	var s struct {
+		QuotaSet *QuotaSet `json:"quota_set"`
+	}
+	err := r.ExtractInto(&s)
+	return s.QuotaSet, err
+}
+
+// GetResult is the response from a Get or GetDefaults operation. Call its
+// Extract method to interpret it as a QuotaSet.
+type GetResult struct {
+	quotaResult
+}
+
+// UpdateResult is the response from a Update operation. Call its Extract
+// method to interpret it as a QuotaSet.
+type UpdateResult struct {
+	quotaResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	eclcloud.ErrResult
+}
+
+// GetUsageResult is the response from a GetUsage operation. Call its Extract
+// method to interpret it as a QuotaUsageSet.
+type GetUsageResult struct {
+	eclcloud.Result
+}
+
+// Extract is a method that attempts to interpret a GetUsageResult as a
+// QuotaUsageSet struct.
+func (r GetUsageResult) Extract() (QuotaUsageSet, error) {
+	var s struct {
+		QuotaUsageSet QuotaUsageSet `json:"quota_set"`
+	}
+	err := r.ExtractInto(&s)
+	return s.QuotaUsageSet, err
+}
@@ -0,0 +1,2 @@
+// quotasets unit tests
+package testing
@@ -0,0 +1,108 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/computevolume/extensions/quotasets"
+)
+
+const tenantID = "9f8a1e6c3d5b4e2f8a7c6b5d4e3f2a1b"
+
+const getResponse = `{
+	"quota_set": {
+		"id": "9f8a1e6c3d5b4e2f8a7c6b5d4e3f2a1b",
+		"volumes": 10,
+		"snapshots": 10,
+		"gigabytes": 1000,
+		"per_volume_gigabytes": -1,
+		"backups": 10,
+		"backup_gigabytes": 1000
+	}
+}`
+
+const getUsageResponse = `{
+	"quota_set": {
+		"id": "9f8a1e6c3d5b4e2f8a7c6b5d4e3f2a1b",
+		"volumes": {
+			"in_use": 8,
+			"allocated": 0,
+			"reserved": 0,
+			"limit": 10
+		},
+		"snapshots": {
+			"in_use": 2,
+			"allocated": 0,
+			"reserved": 0,
+			"limit": 10
+		},
+		"gigabytes": {
+			"in_use": 900,
+			"allocated": 0,
+			"reserved": 40,
+			"limit": 1000
+		},
+		"per_volume_gigabytes": {
+			"in_use": 0,
+			"allocated": 0,
+			"reserved": 0,
+			"limit": -1
+		},
+		"backups": {
+			"in_use": 0,
+			"allocated": 0,
+			"reserved": 0,
+			"limit": 10
+		},
+		"backup_gigabytes": {
+			"in_use": 0,
+			"allocated": 0,
+			"reserved": 0,
+			"limit": 1000
+		}
+	}
+}`
+
+const updateRequest = `{
+	"quota_set": {
+		"volumes": 20,
+		"gigabytes": 2000
+	}
+}`
+
+const updateResponse = `{
+	"quota_set": {
+		"volumes": 20,
+		"snapshots": 10,
+		"gigabytes": 2000,
+		"per_volume_gigabytes": -1,
+		"backups": 10,
+		"backup_gigabytes": 1000
+	}
+}`
+
+var expectedQuotaSet = quotasets.QuotaSet{
+	ID:                 tenantID,
+	Volumes:            10,
+	Snapshots:          10,
+	Gigabytes:          1000,
+	PerVolumeGigabytes: -1,
+	Backups:            10,
+	BackupGigabytes:    1000,
+}
+
+var expectedQuotaUsageSet = quotasets.QuotaUsageSet{
+	ID:                 tenantID,
+	Volumes:            quotasets.QuotaUsage{InUse: 8, Limit: 10},
+	Snapshots:          quotasets.QuotaUsage{InUse: 2, Limit: 10},
+	Gigabytes:          quotasets.QuotaUsage{InUse: 900, Reserved: 40, Limit: 1000},
+	PerVolumeGigabytes: quotasets.QuotaUsage{Limit: -1},
+	Backups:            quotasets.QuotaUsage{Limit: 10},
+	BackupGigabytes:    quotasets.QuotaUsage{Limit: 1000},
+}
+
+var expectedUpdatedQuotaSet = quotasets.QuotaSet{
+	Volumes:            20,
+	Snapshots:          10,
+	Gigabytes:          2000,
+	PerVolumeGigabytes: -1,
+	Backups:            10,
+	BackupGigabytes:    1000,
+}
@@ -0,0 +1,89 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/computevolume/extensions/quotasets"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-quota-sets/"+tenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, getResponse)
+	})
+
+	actual, err := quotasets.Get(fakeclient.ServiceClient(), tenantID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expectedQuotaSet, actual)
+}
+
+func TestGetUsage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-quota-sets/"+tenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{"usage": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, getUsageResponse)
+	})
+
+	actual, err := quotasets.GetUsage(fakeclient.ServiceClient(), tenantID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedQuotaUsageSet, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-quota-sets/"+tenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, updateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, updateResponse)
+	})
+
+	updateOpts := quotasets.UpdateOpts{
+		Volumes:   eclcloud.IntToPointer(20),
+		Gigabytes: eclcloud.IntToPointer(2000),
+	}
+	actual, err := quotasets.Update(fakeclient.ServiceClient(), tenantID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expectedUpdatedQuotaSet, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-quota-sets/"+tenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := quotasets.Delete(fakeclient.ServiceClient(), tenantID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,53 @@
+/*
+Package transfers provides information and interaction with volume transfers
+in the Enterprise Cloud Block Storage service. A transfer moves a volume from
+the project which creates the transfer to the project which accepts it, using
+the ID and authentication key of the transfer.
+
+Example to Create a Transfer
+
+	createOpts := transfers.CreateOpts{
+		VolumeID: "volume-id",
+		Name:     "move-to-production",
+	}
+
+	transfer, err := transfers.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// transfer.ID and transfer.AuthKey must be passed to the receiving
+	// project. The authentication key is only returned by Create.
+	fmt.Println(transfer.ID, transfer.AuthKey)
+
+Example to Accept a Transfer in the Receiving Project
+
+	acceptOpts := transfers.AcceptOpts{
+		AuthKey: "auth-key",
+	}
+
+	transfer, err := transfers.Accept(receivingClient, "transfer-id", acceptOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = volumes.WaitForStatus(receivingClient, transfer.VolumeID, "available", 300)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Wait for a Transfer to be Accepted
+
+	err := transfers.WaitForAccept(client, "transfer-id", 3600)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Cancel a Transfer
+
+	err := transfers.Delete(client, "transfer-id").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package transfers
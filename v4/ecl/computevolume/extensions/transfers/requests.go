@@ -0,0 +1,134 @@
+package transfers
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToTransferCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains options for creating a Transfer. This object is passed
+// to the transfers.Create function.
+type CreateOpts struct {
+	// The ID of the volume to transfer
+	VolumeID string `json:"volume_id" required:"true"`
+	// The transfer name
+	Name string `json:"name,omitempty"`
+}
+
+// ToTransferCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToTransferCreateMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "transfer")
+}
+
+// Create will create a new Transfer of a volume based on the values in
+// CreateOpts. To extract the Transfer object, including its AuthKey, from the
+// response, call the Extract method on the CreateResult.
+func Create(client *eclcloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTransferCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// AcceptOptsBuilder allows extensions to add additional parameters to the
+// Accept request.
+type AcceptOptsBuilder interface {
+	ToTransferAcceptMap() (map[string]interface{}, error)
+}
+
+// AcceptOpts contains options for accepting a Transfer.
+type AcceptOpts struct {
+	// AuthKey is the authentication key returned when the transfer was
+	// created
+	AuthKey string `json:"auth_key" required:"true"`
+}
+
+// ToTransferAcceptMap assembles a request body based on the contents of an
+// AcceptOpts.
+func (opts AcceptOpts) ToTransferAcceptMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "accept")
+}
+
+// Accept will accept the Transfer with the provided ID, moving its volume to
+// the project of client. To extract the Transfer object from the response,
+// call the Extract method on the AcceptResult.
+func Accept(client *eclcloud.ServiceClient, id string, opts AcceptOptsBuilder) (r AcceptResult) {
+	b, err := opts.ToTransferAcceptMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(acceptURL(client, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Delete will delete the Transfer with the provided ID, cancelling it.
+func Delete(client *eclcloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
+
+// Get retrieves the Transfer with the provided ID. To extract the Transfer
+// object from the response, call the Extract method on the GetResult.
+func Get(client *eclcloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToTransferListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Transfers. It is passed to the
+// transfers.List function.
+type ListOpts struct {
+	// AllTenants will retrieve transfers of all tenants/projects.
+	AllTenants bool `q:"all_tenants"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToTransferListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTransferListQuery() (string, error) {
+	q, err := eclcloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns the pending Transfers optionally limited by the conditions
+// provided in ListOpts.
+func List(client *eclcloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToTransferListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TransferPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
@@ -0,0 +1,114 @@
+package transfers
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// Transfer contains all the information associated with an Enterprise Cloud
+// volume transfer.
+type Transfer struct {
+	// Unique identifier for the transfer.
+	ID string `json:"id"`
+	// Human-readable display name for the transfer.
+	Name string `json:"name"`
+	// The ID of the volume being transferred.
+	VolumeID string `json:"volume_id"`
+	// AuthKey is the key the receiving project needs to accept the transfer.
+	// It is only set in the result of Create.
+	AuthKey string `json:"auth_key"`
+	// The date when this transfer was created.
+	CreatedAt time.Time `json:"-"`
+}
+
+func (r *Transfer) UnmarshalJSON(b []byte) error {
+	type tmp Transfer
+	var s struct {
+		tmp
+		CreatedAt eclcloud.JSONRFC3339MilliNoZ `json:"created_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Transfer(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+
+	return err
+}
+
+// TransferPage is a pagination.pager that is returned from a call to the List
+// function.
+type TransferPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a ListResult contains no Transfers.
+func (r TransferPage) IsEmpty() (bool, error) {
+	transfers, err := ExtractTransfers(r)
+	return len(transfers) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r TransferPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []eclcloud.Link `json:"transfers_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return eclcloud.ExtractNextURL(s.Links)
+}
+
+// ExtractTransfers extracts and returns Transfers. It is used while iterating
+// over a transfers.List call.
+func ExtractTransfers(r pagination.Page) ([]Transfer, error) {
+	var s []Transfer
+	err := ExtractTransfersInto(r, &s)
+	return s, err
+}
+
+type commonResult struct {
+	eclcloud.Result
+}
+
+// Extract will get the Transfer object out of the commonResult object.
+func (r commonResult) Extract() (*Transfer, error) {
+	var s Transfer
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "transfer")
+}
+
+func ExtractTransfersInto(r pagination.Page, v interface{}) error {
+	return r.(TransferPage).Result.ExtractIntoSlicePtr(v, "transfers")
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// AcceptResult contains the response body and error from an Accept request.
+type AcceptResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	eclcloud.ErrResult
+}
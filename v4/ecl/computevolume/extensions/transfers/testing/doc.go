@@ -0,0 +1,2 @@
+// transfers unittest
+package testing
@@ -0,0 +1,95 @@
+package testing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4/ecl/computevolume/extensions/transfers"
+)
+
+const idTransfer1 = "b8a3c1d2-4e5f-4a6b-9c7d-8e9f0a1b2c3d"
+const idTransfer2 = "d2c1b0a9-8f7e-4d6c-b5a4-3f2e1d0c9b8a"
+
+const idVolume1 = "251df9eb-c088-4e71-808b-75a690e8814b"
+const idVolume2 = "7e0b432b-c922-49d7-b85a-28ac88164328"
+
+const nameTransfer1 = "transfer1"
+
+const authKey = "9266c59563c84664"
+
+const createdAt = "2019-02-06T08:06:57.000000"
+
+var timeCreatedAt = time.Date(2019, 2, 6, 8, 6, 57, 0, time.UTC)
+
+var listResponse = fmt.Sprintf(`{
+	"transfers": [{
+		"id": "%s",
+		"name": "%s",
+		"volume_id": "%s",
+		"created_at": "%s",
+		"links": [{
+			"href": "dummy_self_link",
+			"rel": "self"
+		}]
+	}, {
+		"id": "%s",
+		"name": "transfer2",
+		"volume_id": "%s",
+		"created_at": "%s"
+	}]
+}`, idTransfer1, nameTransfer1, idVolume1, createdAt, idTransfer2, idVolume2, createdAt)
+
+var getResponse = fmt.Sprintf(`{
+	"transfer": {
+		"id": "%s",
+		"name": "%s",
+		"volume_id": "%s",
+		"created_at": "%s"
+	}
+}`, idTransfer1, nameTransfer1, idVolume1, createdAt)
+
+var createRequest = fmt.Sprintf(`{
+	"transfer": {
+		"volume_id": "%s",
+		"name": "%s"
+	}
+}`, idVolume1, nameTransfer1)
+
+var createResponse = fmt.Sprintf(`{
+	"transfer": {
+		"id": "%s",
+		"name": "%s",
+		"volume_id": "%s",
+		"auth_key": "%s",
+		"created_at": "%s"
+	}
+}`, idTransfer1, nameTransfer1, idVolume1, authKey, createdAt)
+
+var acceptRequest = fmt.Sprintf(`{
+	"accept": {
+		"auth_key": "%s"
+	}
+}`, authKey)
+
+var acceptResponse = fmt.Sprintf(`{
+	"transfer": {
+		"id": "%s",
+		"name": "%s",
+		"volume_id": "%s"
+	}
+}`, idTransfer1, nameTransfer1, idVolume1)
+
+var expectedTransfersSlice = []transfers.Transfer{
+	{
+		ID:        idTransfer1,
+		Name:      nameTransfer1,
+		VolumeID:  idVolume1,
+		CreatedAt: timeCreatedAt,
+	},
+	{
+		ID:        idTransfer2,
+		Name:      "transfer2",
+		VolumeID:  idVolume2,
+		CreatedAt: timeCreatedAt,
+	},
+}
@@ -0,0 +1,156 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/computevolume/extensions/transfers"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestListTransferAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-volume-transfer/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, listResponse)
+	})
+
+	allPages, err := transfers.List(fakeclient.ServiceClient(), nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := transfers.ExtractTransfers(allPages)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, expectedTransfersSlice, actual)
+}
+
+func TestGetTransfer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/os-volume-transfer/%s", idTransfer1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, getResponse)
+	})
+
+	tr, err := transfers.Get(fakeclient.ServiceClient(), idTransfer1).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, &expectedTransfersSlice[0], tr)
+}
+
+func TestCreateTransfer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-volume-transfer", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, createRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+
+		fmt.Fprintf(w, createResponse)
+	})
+
+	options := transfers.CreateOpts{
+		VolumeID: idVolume1,
+		Name:     nameTransfer1,
+	}
+	tr, err := transfers.Create(fakeclient.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, &transfers.Transfer{
+		ID:        idTransfer1,
+		Name:      nameTransfer1,
+		VolumeID:  idVolume1,
+		AuthKey:   authKey,
+		CreatedAt: timeCreatedAt,
+	}, tr)
+}
+
+func TestAcceptTransfer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/os-volume-transfer/%s/accept", idTransfer1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, acceptRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+
+		fmt.Fprintf(w, acceptResponse)
+	})
+
+	tr, err := transfers.Accept(fakeclient.ServiceClient(), idTransfer1, transfers.AcceptOpts{AuthKey: authKey}).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, idTransfer1, tr.ID)
+	th.AssertEquals(t, idVolume1, tr.VolumeID)
+}
+
+func TestAcceptTransferRequiresAuthKey(t *testing.T) {
+	res := transfers.Accept(fakeclient.ServiceClient(), idTransfer1, transfers.AcceptOpts{})
+	if res.Err == nil {
+		t.Fatal("expected an error without AuthKey")
+	}
+}
+
+func TestDeleteTransfer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/os-volume-transfer/%s", idTransfer1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	res := transfers.Delete(fakeclient.ServiceClient(), idTransfer1)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestWaitForAccept(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := 0
+	url := fmt.Sprintf("/os-volume-transfer/%s", idTransfer1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		calls++
+		if calls > 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, getResponse)
+	})
+
+	err := transfers.WaitForAccept(fakeclient.ServiceClient(), idTransfer1, 5)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, calls)
+}
@@ -0,0 +1,23 @@
+package transfers
+
+import "github.com/nttcom/eclcloud/v4"
+
+func createURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL("os-volume-transfer")
+}
+
+func listURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL("os-volume-transfer", "detail")
+}
+
+func deleteURL(c *eclcloud.ServiceClient, id string) string {
+	return c.ServiceURL("os-volume-transfer", id)
+}
+
+func getURL(c *eclcloud.ServiceClient, id string) string {
+	return deleteURL(c, id)
+}
+
+func acceptURL(c *eclcloud.ServiceClient, id string) string {
+	return c.ServiceURL("os-volume-transfer", id, "accept")
+}
@@ -0,0 +1,22 @@
+package transfers
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// WaitForAccept will continually poll the transfer until it no longer exists,
+// which is the case once the receiving project has accepted it, or it has
+// been deleted. It will do this for the amount of seconds defined.
+func WaitForAccept(c *eclcloud.ServiceClient, id string, secs int) error {
+	return eclcloud.WaitFor(secs, func() (bool, error) {
+		_, err := Get(c, id).Extract()
+		if err != nil {
+			if _, ok := err.(eclcloud.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+
+		return false, nil
+	})
+}
@@ -69,7 +69,7 @@ Example of Initializing a Volume Connection
 
 	fmt.Printf("%+v\n", connectionInfo["data"])
 
-	terminateOpts := &volumeactions.InitializeConnectionOpts{
+	terminateOpts := &volumeactions.TerminateConnectionOpts{
 		IP:        "127.0.0.1",
 		Host:      "stack",
 		Initiator: "iqn.1994-05.com.redhat:17cf566367d2",
@@ -82,5 +82,47 @@ Example of Initializing a Volume Connection
 	if err != nil {
 		panic(err)
 	}
+
+Example of Reserving a Volume for an Attachment Outside the Compute Service
+
+	err := volumeactions.Reserve(client, volume.ID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	attachOpts := volumeactions.AttachOpts{
+		HostName: "baremetal-host",
+		Mode:     volumeactions.ReadWrite,
+	}
+
+	err = volumeactions.Attach(client, volume.ID, attachOpts).ExtractErr()
+	if err != nil {
+		volumeactions.Unreserve(client, volume.ID)
+		panic(err)
+	}
+
+Example of Setting the Bootable and Read-only Flags of a Volume
+
+	err := volumeactions.SetBootable(client, volume.ID, volumeactions.SetBootableOpts{Bootable: true}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = volumeactions.SetReadonly(client, volume.ID, volumeactions.SetReadonlyOpts{Readonly: true}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Changing the Type of a Volume
+
+	changeTypeOpts := volumeactions.ChangeTypeOpts{
+		NewType:         "ssd",
+		MigrationPolicy: volumeactions.MigrationPolicyOnDemand,
+	}
+
+	err := volumeactions.ChangeType(client, volume.ID, changeTypeOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package volumeactions
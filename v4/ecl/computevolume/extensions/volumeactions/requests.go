@@ -82,3 +82,322 @@ func ForceDelete(client *eclcloud.ServiceClient, id string) (r ForceDeleteResult
 	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"os-force_delete": ""}, nil, nil)
 	return
 }
+
+// AttachOptsBuilder allows extensions to add additional parameters to the
+// Attach request.
+type AttachOptsBuilder interface {
+	ToVolumeAttachMap() (map[string]interface{}, error)
+}
+
+// AttachMode describes the attachment mode for volumes.
+type AttachMode string
+
+// These constants determine how a volume is attached.
+const (
+	ReadOnly  AttachMode = "ro"
+	ReadWrite AttachMode = "rw"
+)
+
+// AttachOpts contains options for attaching a Volume. Volumes of virtual
+// servers are attached through the Compute service with volumeattach.Create,
+// which performs this action itself; Attach only records the attachment in
+// the Block Storage service, e.g. for baremetal servers or iSCSI initiators.
+type AttachOpts struct {
+	// The mountpoint of this volume.
+	MountPoint string `json:"mountpoint,omitempty"`
+
+	// The nova instance ID, can't set simultaneously with HostName.
+	InstanceUUID string `json:"instance_uuid,omitempty"`
+
+	// The hostname of baremetal host, can't set simultaneously with InstanceUUID.
+	HostName string `json:"host_name,omitempty"`
+
+	// Mount mode of this volume.
+	Mode AttachMode `json:"mode,omitempty"`
+}
+
+// ToVolumeAttachMap assembles a request body based on the contents of a
+// AttachOpts.
+func (opts AttachOpts) ToVolumeAttachMap() (map[string]interface{}, error) {
+	if (opts.InstanceUUID == "") == (opts.HostName == "") {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "volumeactions.AttachOpts.InstanceUUID/HostName"
+		err.Info = "Exactly one of InstanceUUID and HostName must be provided"
+		return nil, err
+	}
+	if opts.Mode != "" && opts.Mode != ReadOnly && opts.Mode != ReadWrite {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "volumeactions.AttachOpts.Mode"
+		err.Value = opts.Mode
+		err.Info = "Mode must be ro or rw"
+		return nil, err
+	}
+	return eclcloud.BuildRequestBody(opts, "os-attach")
+}
+
+// Attach will attach a volume based on the values in AttachOpts.
+func Attach(client *eclcloud.ServiceClient, id string, opts AttachOptsBuilder) (r AttachResult) {
+	b, err := opts.ToVolumeAttachMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// BeginDetaching will mark the volume as detaching.
+func BeginDetaching(client *eclcloud.ServiceClient, id string) (r BeginDetachingResult) {
+	b := map[string]interface{}{"os-begin_detaching": make(map[string]interface{})}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// DetachOptsBuilder allows extensions to add additional parameters to the
+// Detach request.
+type DetachOptsBuilder interface {
+	ToVolumeDetachMap() (map[string]interface{}, error)
+}
+
+// DetachOpts contains options for detaching a Volume.
+type DetachOpts struct {
+	// AttachmentID is the ID of the attachment between a volume and instance.
+	// It is required if the volume has more than one attachment.
+	AttachmentID string `json:"attachment_id,omitempty"`
+}
+
+// ToVolumeDetachMap assembles a request body based on the contents of a
+// DetachOpts.
+func (opts DetachOpts) ToVolumeDetachMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "os-detach")
+}
+
+// Detach will detach a volume based on volume ID.
+func Detach(client *eclcloud.ServiceClient, id string, opts DetachOptsBuilder) (r DetachResult) {
+	b, err := opts.ToVolumeDetachMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Reserve will reserve a volume based on volume ID, marking it as attaching
+// so that it cannot be attached anywhere else.
+func Reserve(client *eclcloud.ServiceClient, id string) (r ReserveResult) {
+	b := map[string]interface{}{"os-reserve": make(map[string]interface{})}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	return
+}
+
+// Unreserve will unreserve a volume based on volume ID.
+func Unreserve(client *eclcloud.ServiceClient, id string) (r UnreserveResult) {
+	b := map[string]interface{}{"os-unreserve": make(map[string]interface{})}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	return
+}
+
+// InitializeConnectionOptsBuilder allows extensions to add additional
+// parameters to the InitializeConnection request.
+type InitializeConnectionOptsBuilder interface {
+	ToVolumeInitializeConnectionMap() (map[string]interface{}, error)
+}
+
+// InitializeConnectionOpts hosts options for InitializeConnection.
+// The fields are specific to the storage driver in use and the destination
+// attachment.
+type InitializeConnectionOpts struct {
+	IP        string   `json:"ip,omitempty"`
+	Host      string   `json:"host,omitempty"`
+	Initiator string   `json:"initiator,omitempty"`
+	Wwpns     []string `json:"wwpns,omitempty"`
+	Wwnns     string   `json:"wwnns,omitempty"`
+	Multipath *bool    `json:"multipath,omitempty"`
+	Platform  string   `json:"platform,omitempty"`
+	OSType    string   `json:"os_type,omitempty"`
+}
+
+// ToVolumeInitializeConnectionMap assembles a request body based on the
+// contents of a InitializeConnectionOpts.
+func (opts InitializeConnectionOpts) ToVolumeInitializeConnectionMap() (map[string]interface{}, error) {
+	b, err := eclcloud.BuildRequestBody(opts, "connector")
+	return map[string]interface{}{"os-initialize_connection": b}, err
+}
+
+// InitializeConnection initializes an iSCSI connection by volume ID.
+func InitializeConnection(client *eclcloud.ServiceClient, id string, opts InitializeConnectionOptsBuilder) (r InitializeConnectionResult) {
+	b, err := opts.ToVolumeInitializeConnectionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	return
+}
+
+// TerminateConnectionOptsBuilder allows extensions to add additional
+// parameters to the TerminateConnection request.
+type TerminateConnectionOptsBuilder interface {
+	ToVolumeTerminateConnectionMap() (map[string]interface{}, error)
+}
+
+// TerminateConnectionOpts hosts options for TerminateConnection.
+type TerminateConnectionOpts struct {
+	IP        string   `json:"ip,omitempty"`
+	Host      string   `json:"host,omitempty"`
+	Initiator string   `json:"initiator,omitempty"`
+	Wwpns     []string `json:"wwpns,omitempty"`
+	Wwnns     string   `json:"wwnns,omitempty"`
+	Multipath *bool    `json:"multipath,omitempty"`
+	Platform  string   `json:"platform,omitempty"`
+	OSType    string   `json:"os_type,omitempty"`
+}
+
+// ToVolumeTerminateConnectionMap assembles a request body based on the
+// contents of a TerminateConnectionOpts.
+func (opts TerminateConnectionOpts) ToVolumeTerminateConnectionMap() (map[string]interface{}, error) {
+	b, err := eclcloud.BuildRequestBody(opts, "connector")
+	return map[string]interface{}{"os-terminate_connection": b}, err
+}
+
+// TerminateConnection terminates an iSCSI connection by volume ID.
+func TerminateConnection(client *eclcloud.ServiceClient, id string, opts TerminateConnectionOptsBuilder) (r TerminateConnectionResult) {
+	b, err := opts.ToVolumeTerminateConnectionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// SetBootableOptsBuilder allows extensions to add additional parameters to
+// the SetBootable request.
+type SetBootableOptsBuilder interface {
+	ToVolumeSetBootableMap() (map[string]interface{}, error)
+}
+
+// SetBootableOpts contains options for setting the bootable flag of a Volume.
+type SetBootableOpts struct {
+	// Bootable is whether a server can be booted from the volume.
+	Bootable bool `json:"bootable"`
+}
+
+// ToVolumeSetBootableMap assembles a request body based on the contents of a
+// SetBootableOpts.
+func (opts SetBootableOpts) ToVolumeSetBootableMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "os-set_bootable")
+}
+
+// SetBootable will set the bootable flag of a volume.
+func SetBootable(client *eclcloud.ServiceClient, id string, opts SetBootableOptsBuilder) (r SetBootableResult) {
+	b, err := opts.ToVolumeSetBootableMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// SetReadonlyOptsBuilder allows extensions to add additional parameters to
+// the SetReadonly request.
+type SetReadonlyOptsBuilder interface {
+	ToVolumeSetReadonlyMap() (map[string]interface{}, error)
+}
+
+// SetReadonlyOpts contains options for setting the read-only flag of a
+// Volume.
+type SetReadonlyOpts struct {
+	// Readonly is whether the volume is attached in read-only mode.
+	Readonly bool `json:"readonly"`
+}
+
+// ToVolumeSetReadonlyMap assembles a request body based on the contents of a
+// SetReadonlyOpts.
+func (opts SetReadonlyOpts) ToVolumeSetReadonlyMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "os-update_readonly_flag")
+}
+
+// SetReadonly will set the read-only flag of a volume.
+func SetReadonly(client *eclcloud.ServiceClient, id string, opts SetReadonlyOptsBuilder) (r SetReadonlyResult) {
+	b, err := opts.ToVolumeSetReadonlyMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// MigrationPolicy type represents a migration_policy when changing types.
+type MigrationPolicy string
+
+// Supported attributes for MigrationPolicy attribute for changeType operations.
+const (
+	MigrationPolicyNever    MigrationPolicy = "never"
+	MigrationPolicyOnDemand MigrationPolicy = "on-demand"
+)
+
+// ChangeTypeOptsBuilder allows extensions to add additional parameters to the
+// ChangeType request.
+type ChangeTypeOptsBuilder interface {
+	ToVolumeChangeTypeMap() (map[string]interface{}, error)
+}
+
+// ChangeTypeOpts contains options for changing the type of an existing Volume.
+type ChangeTypeOpts struct {
+	// NewType is the name of the new volume type of the volume.
+	NewType string `json:"new_type" required:"true"`
+
+	// MigrationPolicy specifies if the volume should be migrated when it is
+	// re-typed. Possible values are "on-demand" or "never". If not specified,
+	// the default is "never".
+	MigrationPolicy MigrationPolicy `json:"migration_policy,omitempty"`
+}
+
+// ToVolumeChangeTypeMap assembles a request body based on the contents of an
+// ChangeTypeOpts.
+func (opts ChangeTypeOpts) ToVolumeChangeTypeMap() (map[string]interface{}, error) {
+	if opts.MigrationPolicy != "" && opts.MigrationPolicy != MigrationPolicyNever && opts.MigrationPolicy != MigrationPolicyOnDemand {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "volumeactions.ChangeTypeOpts.MigrationPolicy"
+		err.Value = opts.MigrationPolicy
+		err.Info = "MigrationPolicy must be never or on-demand"
+		return nil, err
+	}
+	return eclcloud.BuildRequestBody(opts, "os-retype")
+}
+
+// ChangeType will change the volume type of the volume based on the provided
+// information. This operation does not return a response body.
+func ChangeType(client *eclcloud.ServiceClient, id string, opts ChangeTypeOptsBuilder) (r ChangeTypeResult) {
+	b, err := opts.ToVolumeChangeTypeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -137,3 +137,73 @@ func (r UploadImageResult) Extract() (VolumeImage, error) {
 type ForceDeleteResult struct {
 	eclcloud.ErrResult
 }
+
+// AttachResult contains the response body and error from an Attach request.
+type AttachResult struct {
+	eclcloud.ErrResult
+}
+
+// BeginDetachingResult contains the response body and error from a
+// BeginDetaching request.
+type BeginDetachingResult struct {
+	eclcloud.ErrResult
+}
+
+// DetachResult contains the response body and error from a Detach request.
+type DetachResult struct {
+	eclcloud.ErrResult
+}
+
+// ReserveResult contains the response body and error from a Reserve request.
+type ReserveResult struct {
+	eclcloud.ErrResult
+}
+
+// UnreserveResult contains the response body and error from an Unreserve
+// request.
+type UnreserveResult struct {
+	eclcloud.ErrResult
+}
+
+// TerminateConnectionResult contains the response body and error from a
+// TerminateConnection request.
+type TerminateConnectionResult struct {
+	eclcloud.ErrResult
+}
+
+// InitializeConnectionResult contains the response body and error from an
+// InitializeConnection request.
+type InitializeConnectionResult struct {
+	eclcloud.Result
+}
+
+// Extract will get the connection information out of the
+// InitializeConnectionResult object.
+//
+// This will be a generic map[string]interface{} and the results will be
+// dependent on the type of connection made.
+func (r InitializeConnectionResult) Extract() (map[string]interface{}, error) {
+	var s struct {
+		ConnectionInfo map[string]interface{} `json:"connection_info"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ConnectionInfo, err
+}
+
+// SetBootableResult contains the response body and error from a SetBootable
+// request.
+type SetBootableResult struct {
+	eclcloud.ErrResult
+}
+
+// SetReadonlyResult contains the response body and error from a SetReadonly
+// request.
+type SetReadonlyResult struct {
+	eclcloud.ErrResult
+}
+
+// ChangeTypeResult contains the response body and error from an ChangeType
+// request.
+type ChangeTypeResult struct {
+	eclcloud.ErrResult
+}
@@ -47,3 +47,102 @@ const extendRequest = `{
         "new_size": 40
     }
 }`
+
+var attachRequest = fmt.Sprintf(`{
+	"os-attach": {
+		"mountpoint": "/mnt",
+		"mode": "rw",
+		"instance_uuid": "%s"
+	}
+}`, instanceID)
+
+const beginDetachingRequest = `{
+	"os-begin_detaching": {}
+}`
+
+const detachRequest = `{
+	"os-detach": {
+		"attachment_id": "eb6f0fbc-ad3a-4a3c-9bf5-8b1b8e0a2f3d"
+	}
+}`
+
+const reserveRequest = `{
+	"os-reserve": {}
+}`
+
+const unreserveRequest = `{
+	"os-unreserve": {}
+}`
+
+const initializeConnectionRequest = `{
+	"os-initialize_connection": {
+		"connector": {
+			"ip": "127.0.0.1",
+			"host": "stack",
+			"initiator": "iqn.1994-05.com.redhat:17cf566367d2",
+			"multipath": false,
+			"platform": "x86_64",
+			"os_type": "linux2"
+		}
+	}
+}`
+
+const initializeConnectionResponse = `{
+	"connection_info": {
+		"data": {
+			"target_portals": [
+				"172.31.17.48:3260"
+			],
+			"auth_method": "CHAP",
+			"auth_username": "5MLtcsTEmNN5jFVcT6ui",
+			"access_mode": "rw",
+			"target_lun": 0,
+			"volume_id": "cd281d77-8217-4830-be95-9528227c105c",
+			"target_luns": [
+				0
+			],
+			"target_iqns": [
+				"iqn.2010-10.org.openstack:volume-cd281d77-8217-4830-be95-9528227c105c"
+			],
+			"auth_password": "x854ZY5Re3aCkdNL",
+			"target_discovered": false,
+			"encrypted": false,
+			"qos_specs": null,
+			"target_iqn": "iqn.2010-10.org.openstack:volume-cd281d77-8217-4830-be95-9528227c105c",
+			"target_portal": "172.31.17.48:3260"
+		},
+		"driver_volume_type": "iscsi"
+	}
+}`
+
+const terminateConnectionRequest = `{
+	"os-terminate_connection": {
+		"connector": {
+			"ip": "127.0.0.1",
+			"host": "stack",
+			"initiator": "iqn.1994-05.com.redhat:17cf566367d2",
+			"multipath": true,
+			"platform": "x86_64",
+			"os_type": "linux2"
+		}
+	}
+}`
+
+const setBootableRequest = `{
+	"os-set_bootable": {
+		"bootable": true
+	}
+}`
+
+const setReadonlyRequest = `{
+	"os-update_readonly_flag": {
+		"readonly": false
+	}
+}`
+
+const changeTypeRequest = `{
+	"os-retype": {
+		"new_type": "ssd",
+		"migration_policy": "on-demand"
+	}
+}`
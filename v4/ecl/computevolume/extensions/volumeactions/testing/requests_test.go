@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nttcom/eclcloud/v4"
 	"github.com/nttcom/eclcloud/v4/ecl/computevolume/extensions/volumeactions"
 	th "github.com/nttcom/eclcloud/v4/testhelper"
 	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
@@ -86,3 +87,183 @@ func TestVolumeExtendSize(t *testing.T) {
 	err := volumeactions.ExtendSize(fakeclient.ServiceClient(), volumeID, options).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+// handleAction configures the test server to expect a volume action with the
+// given request body and to respond with the given status and response body.
+func handleAction(t *testing.T, request string, status int, response string) {
+	url := fmt.Sprintf("/volumes/%s/action", volumeID)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, request)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, response)
+	})
+}
+
+func TestVolumeAttach(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleAction(t, attachRequest, http.StatusAccepted, "")
+
+	options := &volumeactions.AttachOpts{
+		MountPoint:   "/mnt",
+		Mode:         volumeactions.ReadWrite,
+		InstanceUUID: instanceID,
+	}
+
+	err := volumeactions.Attach(fakeclient.ServiceClient(), volumeID, options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestVolumeAttachInvalidOpts(t *testing.T) {
+	optsList := []volumeactions.AttachOpts{
+		{MountPoint: "/mnt"},
+		{InstanceUUID: instanceID, HostName: "stack"},
+		{InstanceUUID: instanceID, Mode: "wo"},
+	}
+
+	for _, opts := range optsList {
+		err := volumeactions.Attach(fakeclient.ServiceClient(), volumeID, opts).ExtractErr()
+		if _, ok := err.(eclcloud.ErrInvalidInput); !ok {
+			t.Errorf("expected ErrInvalidInput for %+v, got %v", opts, err)
+		}
+	}
+}
+
+func TestVolumeBeginDetaching(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleAction(t, beginDetachingRequest, http.StatusAccepted, "")
+
+	err := volumeactions.BeginDetaching(fakeclient.ServiceClient(), volumeID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestVolumeDetach(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleAction(t, detachRequest, http.StatusAccepted, "")
+
+	options := &volumeactions.DetachOpts{
+		AttachmentID: "eb6f0fbc-ad3a-4a3c-9bf5-8b1b8e0a2f3d",
+	}
+
+	err := volumeactions.Detach(fakeclient.ServiceClient(), volumeID, options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestVolumeReserve(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleAction(t, reserveRequest, http.StatusOK, "")
+
+	err := volumeactions.Reserve(fakeclient.ServiceClient(), volumeID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestVolumeUnreserve(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleAction(t, unreserveRequest, http.StatusOK, "")
+
+	err := volumeactions.Unreserve(fakeclient.ServiceClient(), volumeID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestVolumeInitializeConnection(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleAction(t, initializeConnectionRequest, http.StatusOK, initializeConnectionResponse)
+
+	options := &volumeactions.InitializeConnectionOpts{
+		IP:        "127.0.0.1",
+		Host:      "stack",
+		Initiator: "iqn.1994-05.com.redhat:17cf566367d2",
+		Multipath: eclcloud.Disabled,
+		Platform:  "x86_64",
+		OSType:    "linux2",
+	}
+
+	info, err := volumeactions.InitializeConnection(fakeclient.ServiceClient(), volumeID, options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "iscsi", info["driver_volume_type"])
+	data := info["data"].(map[string]interface{})
+	th.AssertEquals(t, "172.31.17.48:3260", data["target_portal"])
+	th.AssertEquals(t, "iqn.2010-10.org.openstack:volume-cd281d77-8217-4830-be95-9528227c105c", data["target_iqn"])
+}
+
+func TestVolumeTerminateConnection(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleAction(t, terminateConnectionRequest, http.StatusAccepted, "")
+
+	options := &volumeactions.TerminateConnectionOpts{
+		IP:        "127.0.0.1",
+		Host:      "stack",
+		Initiator: "iqn.1994-05.com.redhat:17cf566367d2",
+		Multipath: eclcloud.Enabled,
+		Platform:  "x86_64",
+		OSType:    "linux2",
+	}
+
+	err := volumeactions.TerminateConnection(fakeclient.ServiceClient(), volumeID, options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestVolumeSetBootable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleAction(t, setBootableRequest, http.StatusOK, "")
+
+	options := volumeactions.SetBootableOpts{
+		Bootable: true,
+	}
+
+	err := volumeactions.SetBootable(fakeclient.ServiceClient(), volumeID, options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestVolumeSetReadonly(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleAction(t, setReadonlyRequest, http.StatusAccepted, "")
+
+	options := volumeactions.SetReadonlyOpts{
+		Readonly: false,
+	}
+
+	err := volumeactions.SetReadonly(fakeclient.ServiceClient(), volumeID, options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestVolumeChangeType(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleAction(t, changeTypeRequest, http.StatusAccepted, "")
+
+	options := &volumeactions.ChangeTypeOpts{
+		NewType:         "ssd",
+		MigrationPolicy: volumeactions.MigrationPolicyOnDemand,
+	}
+
+	err := volumeactions.ChangeType(fakeclient.ServiceClient(), volumeID, options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestVolumeChangeTypeInvalidMigrationPolicy(t *testing.T) {
+	options := volumeactions.ChangeTypeOpts{
+		NewType:         "ssd",
+		MigrationPolicy: "always",
+	}
+
+	err := volumeactions.ChangeType(fakeclient.ServiceClient(), volumeID, options).ExtractErr()
+	if _, ok := err.(eclcloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Package snapshots provides information and interaction with snapshots in the
+Enterprise Cloud Block Storage service. A snapshot is a point in time copy of
+the data contained in a volume, from which new volumes can be created.
+
+Example to List Snapshots
+
+	listOpts := snapshots.ListOpts{
+		VolumeID: "volume-id",
+	}
+
+	allPages, err := snapshots.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allSnapshots, err := snapshots.ExtractSnapshots(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, snapshot := range allSnapshots {
+		fmt.Printf("%+v\n", snapshot)
+	}
+
+Example to Create a Snapshot and Wait for it
+
+	createOpts := snapshots.CreateOpts{
+		VolumeID: "volume-id",
+		Name:     "before-maintenance",
+		Force:    true,
+	}
+
+	snapshot, err := snapshots.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = snapshots.WaitForStatus(client, snapshot.ID, "available", 600)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update the Metadata of a Snapshot
+
+	metadataOpts := snapshots.MetadataOpts{
+		"purpose": "maintenance",
+	}
+
+	metadata, err := snapshots.UpdateMetadata(client, "snapshot-id", metadataOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Snapshot
+
+	err := snapshots.Delete(client, "snapshot-id").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = snapshots.WaitForDelete(client, "snapshot-id", 600)
+	if err != nil {
+		panic(err)
+	}
+*/
+package snapshots
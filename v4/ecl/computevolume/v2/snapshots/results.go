@@ -0,0 +1,148 @@
+package snapshots
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// Snapshot contains all the information associated with an Enterprise Cloud
+// volume snapshot.
+type Snapshot struct {
+	// Unique identifier for the snapshot.
+	ID string `json:"id"`
+	// Current status of the snapshot.
+	Status string `json:"status"`
+	// Size of the snapshot in GB.
+	Size int `json:"size"`
+	// The ID of the volume the snapshot was taken of.
+	VolumeID string `json:"volume_id"`
+	// The date when this snapshot was created.
+	CreatedAt time.Time `json:"-"`
+	// The date when this snapshot was last updated.
+	UpdatedAt time.Time `json:"-"`
+	// Human-readable display name for the snapshot.
+	Name string `json:"name"`
+	// Human-readable description for the snapshot.
+	Description string `json:"description"`
+	// Arbitrary key-value pairs defined by the user.
+	Metadata map[string]string `json:"metadata"`
+	// Progress is how far the creation of the snapshot has come, e.g. "100%".
+	Progress string `json:"os-extended-snapshot-attributes:progress"`
+	// TenantID is the id of the project that owns the snapshot.
+	TenantID string `json:"os-extended-snapshot-attributes:project_id"`
+}
+
+func (r *Snapshot) UnmarshalJSON(b []byte) error {
+	type tmp Snapshot
+	var s struct {
+		tmp
+		CreatedAt eclcloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt eclcloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Snapshot(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
+
+// SnapshotPage is a pagination.pager that is returned from a call to the List
+// function.
+type SnapshotPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a ListResult contains no Snapshots.
+func (r SnapshotPage) IsEmpty() (bool, error) {
+	snapshots, err := ExtractSnapshots(r)
+	return len(snapshots) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r SnapshotPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []eclcloud.Link `json:"snapshots_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return eclcloud.ExtractNextURL(s.Links)
+}
+
+// ExtractSnapshots extracts and returns Snapshots. It is used while iterating
+// over a snapshots.List call.
+func ExtractSnapshots(r pagination.Page) ([]Snapshot, error) {
+	var s []Snapshot
+	err := ExtractSnapshotsInto(r, &s)
+	return s, err
+}
+
+type commonResult struct {
+	eclcloud.Result
+}
+
+// Extract will get the Snapshot object out of the commonResult object.
+func (r commonResult) Extract() (*Snapshot, error) {
+	var s Snapshot
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "snapshot")
+}
+
+func ExtractSnapshotsInto(r pagination.Page, v interface{}) error {
+	return r.(SnapshotPage).Result.ExtractIntoSlicePtr(v, "snapshots")
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult contains the response body and error from an Update request.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	eclcloud.ErrResult
+}
+
+// MetadataResult contains the response body and error from a GetMetadata or
+// UpdateMetadata request.
+type MetadataResult struct {
+	eclcloud.Result
+}
+
+// Extract will get the metadata out of the MetadataResult object.
+func (r MetadataResult) Extract() (map[string]string, error) {
+	var s struct {
+		Metadata map[string]string `json:"metadata"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Metadata, err
+}
+
+// DeleteMetadatumResult contains the response body and error from a
+// DeleteMetadatum request.
+type DeleteMetadatumResult struct {
+	eclcloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// snapshots_v2 unittest
+package testing
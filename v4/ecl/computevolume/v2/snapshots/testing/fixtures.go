@@ -0,0 +1,167 @@
+package testing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4/ecl/computevolume/v2/snapshots"
+)
+
+const idSnapshot1 = "3b4a8f3e-7b0c-4e1b-9a55-0c5a4e2f8d61"
+const idSnapshot2 = "c1a7e0f2-5d3b-4c8e-a9f6-2b7d4e1c0a93"
+
+const idVolume1 = "251df9eb-c088-4e71-808b-75a690e8814b"
+
+const nameSnapshot1 = "snapshot1"
+const nameSnapshot1Update = "snapshot1-update"
+
+const descriptionSnapshot1 = "test snapshot 1"
+
+const tenantID = "9ee80f2a926c49f88f166af47df4e9f5"
+
+const createdAt = "2019-02-06T08:06:57.000000"
+
+var timeCreatedAt = time.Date(2019, 2, 6, 8, 6, 57, 0, time.UTC)
+
+var listResponse = fmt.Sprintf(`{
+	"snapshots": [{
+		"id": "%s",
+		"name": "%s",
+		"description": "%s",
+		"status": "available",
+		"size": 40,
+		"volume_id": "%s",
+		"created_at": "%s",
+		"updated_at": null,
+		"metadata": {
+			"purpose": "maintenance"
+		},
+		"os-extended-snapshot-attributes:progress": "100%%",
+		"os-extended-snapshot-attributes:project_id": "%s"
+	}, {
+		"id": "%s",
+		"name": "snapshot2",
+		"description": "",
+		"status": "creating",
+		"size": 40,
+		"volume_id": "%s",
+		"created_at": "%s",
+		"updated_at": null,
+		"metadata": {},
+		"os-extended-snapshot-attributes:progress": "0%%",
+		"os-extended-snapshot-attributes:project_id": "%s"
+	}]
+}`,
+	idSnapshot1, nameSnapshot1, descriptionSnapshot1, idVolume1, createdAt, tenantID,
+	idSnapshot2, idVolume1, createdAt, tenantID,
+)
+
+var getResponse = fmt.Sprintf(`{
+	"snapshot": {
+		"id": "%s",
+		"name": "%s",
+		"description": "%s",
+		"status": "available",
+		"size": 40,
+		"volume_id": "%s",
+		"created_at": "%s",
+		"updated_at": null,
+		"metadata": {
+			"purpose": "maintenance"
+		},
+		"os-extended-snapshot-attributes:progress": "100%%",
+		"os-extended-snapshot-attributes:project_id": "%s"
+	}
+}`, idSnapshot1, nameSnapshot1, descriptionSnapshot1, idVolume1, createdAt, tenantID)
+
+var createRequest = fmt.Sprintf(`{
+	"snapshot": {
+		"volume_id": "%s",
+		"name": "%s",
+		"description": "%s",
+		"force": true,
+		"metadata": {
+			"purpose": "maintenance"
+		}
+	}
+}`, idVolume1, nameSnapshot1, descriptionSnapshot1)
+
+var createResponse = fmt.Sprintf(`{
+	"snapshot": {
+		"id": "%s",
+		"name": "%s",
+		"description": "%s",
+		"status": "creating",
+		"size": 40,
+		"volume_id": "%s",
+		"created_at": "%s",
+		"updated_at": null,
+		"metadata": {
+			"purpose": "maintenance"
+		}
+	}
+}`, idSnapshot1, nameSnapshot1, descriptionSnapshot1, idVolume1, createdAt)
+
+var updateRequest = fmt.Sprintf(`{
+	"snapshot": {
+		"name": "%s"
+	}
+}`, nameSnapshot1Update)
+
+var updateResponse = fmt.Sprintf(`{
+	"snapshot": {
+		"id": "%s",
+		"name": "%s",
+		"description": "%s",
+		"status": "available",
+		"size": 40,
+		"volume_id": "%s",
+		"created_at": "%s",
+		"updated_at": "%s",
+		"metadata": {
+			"purpose": "maintenance"
+		}
+	}
+}`, idSnapshot1, nameSnapshot1Update, descriptionSnapshot1, idVolume1, createdAt, createdAt)
+
+const metadataRequest = `{
+	"metadata": {
+		"purpose": "backup",
+		"owner": "ops"
+	}
+}`
+
+const metadataResponse = `{
+	"metadata": {
+		"purpose": "backup",
+		"owner": "ops"
+	}
+}`
+
+var expectedSnapshotsSlice = []snapshots.Snapshot{
+	{
+		ID:          idSnapshot1,
+		Name:        nameSnapshot1,
+		Description: descriptionSnapshot1,
+		Status:      "available",
+		Size:        40,
+		VolumeID:    idVolume1,
+		CreatedAt:   timeCreatedAt,
+		Metadata: map[string]string{
+			"purpose": "maintenance",
+		},
+		Progress: "100%",
+		TenantID: tenantID,
+	},
+	{
+		ID:        idSnapshot2,
+		Name:      "snapshot2",
+		Status:    "creating",
+		Size:      40,
+		VolumeID:  idVolume1,
+		CreatedAt: timeCreatedAt,
+		Metadata:  map[string]string{},
+		Progress:  "0%",
+		TenantID:  tenantID,
+	},
+}
@@ -0,0 +1,218 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/computevolume/v2/snapshots"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestListSnapshotAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/snapshots/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{"volume_id": idVolume1})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, listResponse)
+	})
+
+	allPages, err := snapshots.List(fakeclient.ServiceClient(), snapshots.ListOpts{VolumeID: idVolume1}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := snapshots.ExtractSnapshots(allPages)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, expectedSnapshotsSlice, actual)
+}
+
+func TestGetSnapshot(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/snapshots/%s", idSnapshot1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, getResponse)
+	})
+
+	s, err := snapshots.Get(fakeclient.ServiceClient(), idSnapshot1).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, &expectedSnapshotsSlice[0], s)
+}
+
+func TestCreateSnapshot(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, createRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+
+		fmt.Fprint(w, createResponse)
+	})
+
+	options := snapshots.CreateOpts{
+		VolumeID:    idVolume1,
+		Name:        nameSnapshot1,
+		Description: descriptionSnapshot1,
+		Force:       true,
+		Metadata: map[string]string{
+			"purpose": "maintenance",
+		},
+	}
+	s, err := snapshots.Create(fakeclient.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, idSnapshot1, s.ID)
+	th.AssertEquals(t, idVolume1, s.VolumeID)
+	th.AssertEquals(t, "creating", s.Status)
+}
+
+func TestCreateSnapshotRequiresVolumeID(t *testing.T) {
+	res := snapshots.Create(fakeclient.ServiceClient(), snapshots.CreateOpts{Name: nameSnapshot1})
+	if res.Err == nil {
+		t.Fatal("expected an error without VolumeID")
+	}
+}
+
+func TestUpdateSnapshot(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/snapshots/%s", idSnapshot1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, updateRequest)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, updateResponse)
+	})
+
+	name := nameSnapshot1Update
+	s, err := snapshots.Update(fakeclient.ServiceClient(), idSnapshot1, snapshots.UpdateOpts{Name: &name}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, nameSnapshot1Update, s.Name)
+	th.CheckEquals(t, timeCreatedAt, s.UpdatedAt)
+}
+
+func TestDeleteSnapshot(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/snapshots/%s", idSnapshot1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	res := snapshots.Delete(fakeclient.ServiceClient(), idSnapshot1)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestGetSnapshotMetadata(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/snapshots/%s/metadata", idSnapshot1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, metadataResponse)
+	})
+
+	metadata, err := snapshots.GetMetadata(fakeclient.ServiceClient(), idSnapshot1).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, map[string]string{"purpose": "backup", "owner": "ops"}, metadata)
+}
+
+func TestUpdateSnapshotMetadata(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/snapshots/%s/metadata", idSnapshot1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, metadataRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, metadataResponse)
+	})
+
+	opts := snapshots.MetadataOpts{"purpose": "backup", "owner": "ops"}
+	metadata, err := snapshots.UpdateMetadata(fakeclient.ServiceClient(), idSnapshot1, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, map[string]string{"purpose": "backup", "owner": "ops"}, metadata)
+}
+
+func TestDeleteSnapshotMetadatum(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/snapshots/%s/metadata/purpose", idSnapshot1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	res := snapshots.DeleteMetadatum(fakeclient.ServiceClient(), idSnapshot1, "purpose")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/snapshots/%s", idSnapshot1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, getResponse)
+	})
+
+	err := snapshots.WaitForStatus(fakeclient.ServiceClient(), idSnapshot1, "available", 5)
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	url := fmt.Sprintf("/snapshots/%s", idSnapshot1)
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := snapshots.WaitForDelete(fakeclient.ServiceClient(), idSnapshot1, 5)
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,31 @@
+package snapshots
+
+import "github.com/nttcom/eclcloud/v4"
+
+func createURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL("snapshots")
+}
+
+func listURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL("snapshots", "detail")
+}
+
+func deleteURL(c *eclcloud.ServiceClient, id string) string {
+	return c.ServiceURL("snapshots", id)
+}
+
+func getURL(c *eclcloud.ServiceClient, id string) string {
+	return deleteURL(c, id)
+}
+
+func updateURL(c *eclcloud.ServiceClient, id string) string {
+	return deleteURL(c, id)
+}
+
+func metadataURL(c *eclcloud.ServiceClient, id string) string {
+	return c.ServiceURL("snapshots", id, "metadata")
+}
+
+func metadatumURL(c *eclcloud.ServiceClient, id, key string) string {
+	return c.ServiceURL("snapshots", id, "metadata", key)
+}
@@ -0,0 +1,38 @@
+package snapshots
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// WaitForStatus will continually poll the resource, checking for a particular
+// status. It will do this for the amount of seconds defined.
+func WaitForStatus(c *eclcloud.ServiceClient, id, status string, secs int) error {
+	return eclcloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		return false, nil
+	})
+}
+
+// WaitForDelete will continually poll the resource until it no longer exists.
+// It will do this for the amount of seconds defined.
+func WaitForDelete(c *eclcloud.ServiceClient, id string, secs int) error {
+	return eclcloud.WaitFor(secs, func() (bool, error) {
+		_, err := Get(c, id).Extract()
+		if err != nil {
+			if _, ok := err.(eclcloud.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+
+		return false, nil
+	})
+}
@@ -0,0 +1,63 @@
+/*
+Package flavorselector chooses a flavor by the resources a server needs
+instead of by its name.
+
+A Selector lists the flavors of a Source, keeps only those which provide at
+least the requested number of vCPUs, amount of RAM and root disk and, for
+sources which support them, the requested extra specs, and ranks the matches
+so that the smallest fit comes first. Both virtual server flavors of the
+Compute service and baremetal flavors of the Baremetal Server service can be
+used as a Source.
+
+The flavor list is cached by the Selector for the duration of its TTL, so
+that choosing flavors for many servers does not list the flavors every time.
+
+Example to Select a Virtual Server Flavor
+
+	selector := flavorselector.NewSelector(flavorselector.NewComputeSource(computeClient), 10*time.Minute)
+
+	requirements := flavorselector.Requirements{
+		MinVCPUs: 4,
+		MinRAM:   16384,
+		MinDisk:  100,
+	}
+
+	flavor, err := selector.Select(requirements)
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := servers.CreateOpts{
+		Name:      "web-1",
+		ImageRef:  "image-uuid",
+		FlavorRef: flavor.ID,
+	}
+
+Example to List All Matching Baremetal Flavors
+
+	selector := flavorselector.NewSelector(flavorselector.NewBaremetalSource(baremetalClient), time.Hour)
+
+	matches, err := selector.Matches(flavorselector.Requirements{MinVCPUs: 8})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, flavor := range matches {
+		fmt.Printf("%+v\n", flavor)
+	}
+
+Example to Select a Flavor with Extra Specs
+
+	requirements := flavorselector.Requirements{
+		MinVCPUs: 2,
+		ExtraSpecs: map[string]string{
+			"hw:cpu_policy": "dedicated",
+		},
+	}
+
+	flavor, err := selector.Select(requirements)
+	if err != nil {
+		panic(err)
+	}
+*/
+package flavorselector
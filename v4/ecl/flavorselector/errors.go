@@ -0,0 +1,19 @@
+package flavorselector
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrNoFlavorFound is the error when no flavor satisfies the requirements
+// given to Select.
+type ErrNoFlavorFound struct {
+	eclcloud.BaseError
+	Requirements Requirements
+}
+
+func (e ErrNoFlavorFound) Error() string {
+	return fmt.Sprintf("Unable to find a flavor with at least %d vCPUs, %d MB RAM and %d GB disk%s",
+		e.Requirements.MinVCPUs, e.Requirements.MinRAM, e.Requirements.MinDisk, extraSpecsString(e.Requirements.ExtraSpecs))
+}
@@ -0,0 +1,228 @@
+package flavorselector
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// Requirements are the minimum resources a flavor must provide.
+type Requirements struct {
+	// MinVCPUs is the minimum number of (virtual) CPUs.
+	MinVCPUs int
+
+	// MinRAM is the minimum amount of memory, measured in MB.
+	MinRAM int
+
+	// MinDisk is the minimum amount of root disk, measured in GB.
+	MinDisk int
+
+	// ExtraSpecs, if provided, must all be set to the given values in the
+	// extra specs of the flavor. They can only be required from an
+	// ExtraSpecsSource.
+	ExtraSpecs map[string]string
+}
+
+func (r Requirements) validate() error {
+	fields := []struct {
+		name  string
+		value int
+	}{
+		{"MinVCPUs", r.MinVCPUs},
+		{"MinRAM", r.MinRAM},
+		{"MinDisk", r.MinDisk},
+	}
+	for _, f := range fields {
+		if f.value < 0 {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "flavorselector.Requirements." + f.name
+			err.Value = f.value
+			err.Info = fmt.Sprintf("%s must not be negative", f.name)
+			return err
+		}
+	}
+	return nil
+}
+
+// fits reports whether the resources of f satisfy r. Extra specs are not
+// checked.
+func (r Requirements) fits(f Flavor) bool {
+	return f.VCPUs >= r.MinVCPUs && f.RAM >= r.MinRAM && f.Disk >= r.MinDisk
+}
+
+// Selector chooses flavors of a Source by Requirements.
+//
+// The flavors, and the extra specs retrieved for them, are cached for TTL. A
+// TTL of zero or less disables the cache. A Selector is safe for concurrent
+// use.
+type Selector struct {
+	Source Source
+	TTL    time.Duration
+
+	mu         sync.Mutex
+	flavors    []Flavor
+	extraSpecs map[string]map[string]string
+	expires    time.Time
+}
+
+// NewSelector returns a Selector for the flavors of source which caches them
+// for ttl.
+func NewSelector(source Source, ttl time.Duration) *Selector {
+	return &Selector{Source: source, TTL: ttl}
+}
+
+// Invalidate drops the cached flavors, so that the next call to Select or
+// Matches lists them again.
+func (s *Selector) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.flavors = nil
+	s.extraSpecs = nil
+	s.expires = time.Time{}
+}
+
+// Select returns the smallest flavor satisfying r. An ErrNoFlavorFound is
+// returned if there is none.
+func (s *Selector) Select(r Requirements) (*Flavor, error) {
+	matches, err := s.Matches(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, ErrNoFlavorFound{Requirements: r}
+	}
+	return &matches[0], nil
+}
+
+// Matches returns all flavors satisfying r, ranked by how closely they fit:
+// by number of vCPUs, then by RAM, then by disk, smallest first. Flavors
+// which are equal in all three are ordered by name.
+func (s *Selector) Matches(r Requirements) ([]Flavor, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	var specsSource ExtraSpecsSource
+	if len(r.ExtraSpecs) > 0 {
+		var ok bool
+		specsSource, ok = s.Source.(ExtraSpecsSource)
+		if !ok {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "flavorselector.Requirements.ExtraSpecs"
+			err.Value = r.ExtraSpecs
+			err.Info = fmt.Sprintf("The flavors of %T have no extra specs", s.Source)
+			return nil, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	all, err := s.listFlavors()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []Flavor
+	for _, f := range all {
+		if !r.fits(f) {
+			continue
+		}
+		if specsSource != nil {
+			specs, err := s.listExtraSpecs(specsSource, f.ID)
+			if err != nil {
+				return nil, err
+			}
+			if !hasExtraSpecs(specs, r.ExtraSpecs) {
+				continue
+			}
+			f.ExtraSpecs = specs
+		}
+		matches = append(matches, f)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		switch {
+		case a.VCPUs != b.VCPUs:
+			return a.VCPUs < b.VCPUs
+		case a.RAM != b.RAM:
+			return a.RAM < b.RAM
+		case a.Disk != b.Disk:
+			return a.Disk < b.Disk
+		}
+		return a.Name < b.Name
+	})
+
+	return matches, nil
+}
+
+// listFlavors returns the cached flavors, listing them again if the cache
+// has expired. s.mu must be held.
+func (s *Selector) listFlavors() ([]Flavor, error) {
+	now := time.Now()
+	if s.flavors != nil && now.Before(s.expires) {
+		return s.flavors, nil
+	}
+
+	all, err := s.Source.ListFlavors()
+	if err != nil {
+		return nil, err
+	}
+
+	s.flavors = all
+	s.extraSpecs = make(map[string]map[string]string)
+	s.expires = now.Add(s.TTL)
+	if s.TTL <= 0 {
+		s.flavors = nil
+	}
+	return all, nil
+}
+
+// listExtraSpecs returns the cached extra specs of a flavor, retrieving them
+// if they have not been retrieved since the flavors were listed. s.mu must be
+// held.
+func (s *Selector) listExtraSpecs(source ExtraSpecsSource, flavorID string) (map[string]string, error) {
+	if specs, ok := s.extraSpecs[flavorID]; ok {
+		return specs, nil
+	}
+
+	specs, err := source.ListExtraSpecs(flavorID)
+	if err != nil {
+		return nil, err
+	}
+	if specs == nil {
+		specs = map[string]string{}
+	}
+	s.extraSpecs[flavorID] = specs
+	return specs, nil
+}
+
+func hasExtraSpecs(specs, required map[string]string) bool {
+	for k, v := range required {
+		if got, ok := specs[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+func extraSpecsString(specs map[string]string) string {
+	if len(specs) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(specs))
+	for k := range specs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + "=" + specs[k]
+	}
+	return " and extra specs " + strings.Join(pairs, ", ")
+}
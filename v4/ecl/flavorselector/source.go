@@ -0,0 +1,107 @@
+package flavorselector
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	baremetalflavors "github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/flavors"
+	computeflavors "github.com/nttcom/eclcloud/v4/ecl/compute/v2/flavors"
+)
+
+// Flavor is the part of a virtual server or baremetal flavor the Selector
+// uses to match requirements.
+type Flavor struct {
+	// ID is the flavor's unique ID.
+	ID string
+
+	// Name is the name of the flavor.
+	Name string
+
+	// VCPUs is the number of (virtual) CPUs of the flavor.
+	VCPUs int
+
+	// RAM is the amount of memory, measured in MB.
+	RAM int
+
+	// Disk is the amount of root disk, measured in GB.
+	Disk int
+
+	// ExtraSpecs are the extra specs of the flavor. They are only retrieved
+	// when the requirements contain extra specs.
+	ExtraSpecs map[string]string
+}
+
+// Source lists the flavors a Selector chooses from.
+type Source interface {
+	ListFlavors() ([]Flavor, error)
+}
+
+// ExtraSpecsSource is a Source whose flavors have extra specs.
+type ExtraSpecsSource interface {
+	Source
+	ListExtraSpecs(flavorID string) (map[string]string, error)
+}
+
+// ComputeSource is a Source of virtual server flavors of the Compute service.
+type ComputeSource struct {
+	Client *eclcloud.ServiceClient
+
+	// ListOpts, if provided, filters the flavors listed.
+	ListOpts computeflavors.ListOpts
+}
+
+// NewComputeSource returns a ComputeSource listing the flavors available to
+// the project of client.
+func NewComputeSource(client *eclcloud.ServiceClient) ComputeSource {
+	return ComputeSource{Client: client}
+}
+
+// ListFlavors lists all virtual server flavors.
+func (s ComputeSource) ListFlavors() ([]Flavor, error) {
+	allPages, err := computeflavors.ListDetail(s.Client, s.ListOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	all, err := computeflavors.ExtractFlavors(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Flavor, len(all))
+	for i, f := range all {
+		result[i] = Flavor{ID: f.ID, Name: f.Name, VCPUs: f.VCPUs, RAM: f.RAM, Disk: f.Disk}
+	}
+	return result, nil
+}
+
+// ListExtraSpecs retrieves the extra specs of a virtual server flavor.
+func (s ComputeSource) ListExtraSpecs(flavorID string) (map[string]string, error) {
+	return computeflavors.ListExtraSpecs(s.Client, flavorID).Extract()
+}
+
+// BaremetalSource is a Source of flavors of the Baremetal Server service.
+// Baremetal flavors have no extra specs.
+type BaremetalSource struct {
+	Client *eclcloud.ServiceClient
+}
+
+// NewBaremetalSource returns a BaremetalSource listing the flavors of client.
+func NewBaremetalSource(client *eclcloud.ServiceClient) BaremetalSource {
+	return BaremetalSource{Client: client}
+}
+
+// ListFlavors lists all baremetal flavors.
+func (s BaremetalSource) ListFlavors() ([]Flavor, error) {
+	allPages, err := baremetalflavors.List(s.Client, nil).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	all, err := baremetalflavors.ExtractFlavors(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Flavor, len(all))
+	for i, f := range all {
+		result[i] = Flavor{ID: f.ID, Name: f.Name, VCPUs: f.VCPUs, RAM: f.RAM, Disk: f.Disk}
+	}
+	return result, nil
+}
@@ -0,0 +1,2 @@
+// flavorselector unit tests
+package testing
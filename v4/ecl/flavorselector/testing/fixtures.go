@@ -0,0 +1,197 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/flavorselector"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+// ComputeListOutput is a sample response to a compute flavors ListDetail
+// call. The flavors are deliberately not ordered by size.
+const ComputeListOutput = `
+{
+    "flavors": [
+        {
+            "id": "8f0a6b8f-4d6b-4a5d-9f0e-4c3b2a1d0e9f",
+            "name": "4CPU-32GB",
+            "vcpus": 4,
+            "ram": 32768,
+            "disk": 100,
+            "swap": "",
+            "rxtx_factor": 1.0,
+            "os-flavor-access:is_public": true,
+            "OS-FLV-EXT-DATA:ephemeral": 0
+        },
+        {
+            "id": "1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f",
+            "name": "4CPU-16GB",
+            "vcpus": 4,
+            "ram": 16384,
+            "disk": 100,
+            "swap": "",
+            "rxtx_factor": 1.0,
+            "os-flavor-access:is_public": true,
+            "OS-FLV-EXT-DATA:ephemeral": 0
+        },
+        {
+            "id": "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+            "name": "2CPU-8GB",
+            "vcpus": 2,
+            "ram": 8192,
+            "disk": 40,
+            "swap": "",
+            "rxtx_factor": 1.0,
+            "os-flavor-access:is_public": true,
+            "OS-FLV-EXT-DATA:ephemeral": 0
+        },
+        {
+            "id": "3c4d5e6f-7a8b-4c9d-0e1f-2a3b4c5d6e7f",
+            "name": "8CPU-16GB",
+            "vcpus": 8,
+            "ram": 16384,
+            "disk": 100,
+            "swap": "",
+            "rxtx_factor": 1.0,
+            "os-flavor-access:is_public": true,
+            "OS-FLV-EXT-DATA:ephemeral": 0
+        }
+    ]
+}
+`
+
+// ExtraSpecsOutputs are sample responses to ListExtraSpecs calls, by flavor
+// ID.
+var ExtraSpecsOutputs = map[string]string{
+	"8f0a6b8f-4d6b-4a5d-9f0e-4c3b2a1d0e9f": `{"extra_specs": {"hw:cpu_policy": "dedicated"}}`,
+	"1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f": `{"extra_specs": {"hw:cpu_policy": "shared"}}`,
+	"3c4d5e6f-7a8b-4c9d-0e1f-2a3b4c5d6e7f": `{"extra_specs": {}}`,
+}
+
+// BaremetalListOutput is a sample response to a baremetal flavors List call.
+const BaremetalListOutput = `
+{
+    "flavors": [
+        {
+            "id": "303b4993-cf29-4301-abd0-99512b5413a5",
+            "name": "General Purpose 2",
+            "vcpus": 8,
+            "ram": 262144,
+            "disk": 3950
+        },
+        {
+            "id": "cebf8bb5-74cf-4a53-bca5-b90d4bbe8d79",
+            "name": "General Purpose 1",
+            "vcpus": 4,
+            "ram": 32768,
+            "disk": 550
+        }
+    ]
+}
+`
+
+var (
+	// Compute2CPU8GB is the 2CPU-8GB flavor of ComputeListOutput.
+	Compute2CPU8GB = flavorselector.Flavor{
+		ID:    "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+		Name:  "2CPU-8GB",
+		VCPUs: 2,
+		RAM:   8192,
+		Disk:  40,
+	}
+
+	// Compute4CPU16GB is the 4CPU-16GB flavor of ComputeListOutput.
+	Compute4CPU16GB = flavorselector.Flavor{
+		ID:    "1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f",
+		Name:  "4CPU-16GB",
+		VCPUs: 4,
+		RAM:   16384,
+		Disk:  100,
+	}
+
+	// Compute4CPU32GB is the 4CPU-32GB flavor of ComputeListOutput.
+	Compute4CPU32GB = flavorselector.Flavor{
+		ID:    "8f0a6b8f-4d6b-4a5d-9f0e-4c3b2a1d0e9f",
+		Name:  "4CPU-32GB",
+		VCPUs: 4,
+		RAM:   32768,
+		Disk:  100,
+	}
+
+	// Compute8CPU16GB is the 8CPU-16GB flavor of ComputeListOutput.
+	Compute8CPU16GB = flavorselector.Flavor{
+		ID:    "3c4d5e6f-7a8b-4c9d-0e1f-2a3b4c5d6e7f",
+		Name:  "8CPU-16GB",
+		VCPUs: 8,
+		RAM:   16384,
+		Disk:  100,
+	}
+
+	// BaremetalGeneralPurpose1 is the first baremetal flavor by size.
+	BaremetalGeneralPurpose1 = flavorselector.Flavor{
+		ID:    "cebf8bb5-74cf-4a53-bca5-b90d4bbe8d79",
+		Name:  "General Purpose 1",
+		VCPUs: 4,
+		RAM:   32768,
+		Disk:  550,
+	}
+
+	// BaremetalGeneralPurpose2 is the second baremetal flavor by size.
+	BaremetalGeneralPurpose2 = flavorselector.Flavor{
+		ID:    "303b4993-cf29-4301-abd0-99512b5413a5",
+		Name:  "General Purpose 2",
+		VCPUs: 8,
+		RAM:   262144,
+		Disk:  3950,
+	}
+)
+
+// HandleComputeListSuccessfully configures the test server to respond to a
+// compute flavors ListDetail request. The returned counter is incremented on
+// every request.
+func HandleComputeListSuccessfully(t *testing.T) *int {
+	calls := 0
+	th.Mux.HandleFunc("/flavors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		calls++
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ComputeListOutput)
+	})
+	return &calls
+}
+
+// HandleExtraSpecsSuccessfully configures the test server to respond to
+// ListExtraSpecs requests for the flavors in ExtraSpecsOutputs. The returned
+// map counts the requests by flavor ID.
+func HandleExtraSpecsSuccessfully(t *testing.T) map[string]int {
+	calls := make(map[string]int)
+	for id, output := range ExtraSpecsOutputs {
+		id, output := id, output
+		th.Mux.HandleFunc("/flavors/"+id+"/os-extra_specs", func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			calls[id]++
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, output)
+		})
+	}
+	return calls
+}
+
+// HandleBaremetalListSuccessfully configures the test server to respond to a
+// baremetal flavors List request.
+func HandleBaremetalListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, BaremetalListOutput)
+	})
+}
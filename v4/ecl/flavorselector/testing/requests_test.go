@@ -0,0 +1,169 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/flavorselector"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestSelectCompute(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleComputeListSuccessfully(t)
+
+	selector := flavorselector.NewSelector(flavorselector.NewComputeSource(client.ServiceClient()), time.Minute)
+
+	actual, err := selector.Select(flavorselector.Requirements{MinVCPUs: 4, MinRAM: 16384, MinDisk: 100})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Compute4CPU16GB, *actual)
+}
+
+func TestMatchesRanksSmallestFirst(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleComputeListSuccessfully(t)
+
+	selector := flavorselector.NewSelector(flavorselector.NewComputeSource(client.ServiceClient()), time.Minute)
+
+	actual, err := selector.Matches(flavorselector.Requirements{})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []flavorselector.Flavor{
+		Compute2CPU8GB, Compute4CPU16GB, Compute4CPU32GB, Compute8CPU16GB,
+	}, actual)
+
+	actual, err = selector.Matches(flavorselector.Requirements{MinRAM: 16385})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []flavorselector.Flavor{Compute4CPU32GB}, actual)
+}
+
+func TestSelectNoMatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleComputeListSuccessfully(t)
+
+	selector := flavorselector.NewSelector(flavorselector.NewComputeSource(client.ServiceClient()), time.Minute)
+
+	_, err := selector.Select(flavorselector.Requirements{MinVCPUs: 16})
+	if _, ok := err.(flavorselector.ErrNoFlavorFound); !ok {
+		t.Fatalf("expected ErrNoFlavorFound, got %v", err)
+	}
+	th.CheckEquals(t, "Unable to find a flavor with at least 16 vCPUs, 0 MB RAM and 0 GB disk", err.Error())
+}
+
+func TestSelectExtraSpecs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleComputeListSuccessfully(t)
+	specsCalls := HandleExtraSpecsSuccessfully(t)
+
+	selector := flavorselector.NewSelector(flavorselector.NewComputeSource(client.ServiceClient()), time.Minute)
+
+	requirements := flavorselector.Requirements{
+		MinVCPUs:   4,
+		ExtraSpecs: map[string]string{"hw:cpu_policy": "dedicated"},
+	}
+
+	expected := Compute4CPU32GB
+	expected.ExtraSpecs = map[string]string{"hw:cpu_policy": "dedicated"}
+
+	for i := 0; i < 2; i++ {
+		actual, err := selector.Select(requirements)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, expected, *actual)
+	}
+
+	// Only flavors with enough resources are looked at, and only once.
+	th.CheckDeepEquals(t, map[string]int{
+		Compute4CPU16GB.ID: 1,
+		Compute4CPU32GB.ID: 1,
+		Compute8CPU16GB.ID: 1,
+	}, specsCalls)
+
+	requirements.ExtraSpecs["hw:cpu_policy"] = "isolate"
+	_, err := selector.Select(requirements)
+	th.CheckEquals(t, "Unable to find a flavor with at least 4 vCPUs, 0 MB RAM and 0 GB disk and extra specs hw:cpu_policy=isolate", err.Error())
+}
+
+func TestSelectBaremetal(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBaremetalListSuccessfully(t)
+
+	selector := flavorselector.NewSelector(flavorselector.NewBaremetalSource(client.ServiceClient()), time.Minute)
+
+	actual, err := selector.Matches(flavorselector.Requirements{MinVCPUs: 4, MinRAM: 32768})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []flavorselector.Flavor{BaremetalGeneralPurpose1, BaremetalGeneralPurpose2}, actual)
+
+	_, err = selector.Select(flavorselector.Requirements{
+		ExtraSpecs: map[string]string{"hw:cpu_policy": "dedicated"},
+	})
+	if _, ok := err.(eclcloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestSelectorCache(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := HandleComputeListSuccessfully(t)
+
+	selector := flavorselector.NewSelector(flavorselector.NewComputeSource(client.ServiceClient()), time.Hour)
+
+	for i := 0; i < 3; i++ {
+		_, err := selector.Select(flavorselector.Requirements{MinVCPUs: 2})
+		th.AssertNoErr(t, err)
+	}
+	th.CheckEquals(t, 1, *calls)
+
+	selector.Invalidate()
+	_, err := selector.Select(flavorselector.Requirements{MinVCPUs: 2})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, *calls)
+}
+
+func TestSelectorCacheDisabled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := HandleComputeListSuccessfully(t)
+
+	selector := flavorselector.NewSelector(flavorselector.NewComputeSource(client.ServiceClient()), 0)
+
+	for i := 0; i < 3; i++ {
+		_, err := selector.Select(flavorselector.Requirements{MinVCPUs: 2})
+		th.AssertNoErr(t, err)
+	}
+	th.CheckEquals(t, 3, *calls)
+}
+
+func TestSelectorCacheExpires(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := HandleComputeListSuccessfully(t)
+
+	selector := flavorselector.NewSelector(flavorselector.NewComputeSource(client.ServiceClient()), 50*time.Millisecond)
+
+	_, err := selector.Select(flavorselector.Requirements{})
+	th.AssertNoErr(t, err)
+	_, err = selector.Select(flavorselector.Requirements{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, *calls)
+
+	time.Sleep(100 * time.Millisecond)
+	_, err = selector.Select(flavorselector.Requirements{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, *calls)
+}
+
+func TestRequirementsValidation(t *testing.T) {
+	selector := flavorselector.NewSelector(flavorselector.NewComputeSource(client.ServiceClient()), time.Minute)
+
+	_, err := selector.Select(flavorselector.Requirements{MinRAM: -1})
+	if _, ok := err.(eclcloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
@@ -44,5 +44,65 @@ Example to Download Image Data
 	if err != nil {
 		panic(err)
 	}
+
+Example to Upload Image Data with Retries and Checksum Verification
+
+	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	imageData, err := os.Open("/path/to/image/file")
+	if err != nil {
+		panic(err)
+	}
+	defer imageData.Close()
+
+	info, err := imageData.Stat()
+	if err != nil {
+		panic(err)
+	}
+
+	uploadOpts := imagedata.UploadOpts{
+		TransferOpts: imagedata.TransferOpts{
+			Context:       ctx,
+			Retries:       3,
+			RetryInterval: 10 * time.Second,
+			Progress: func(transferred, total int64) {
+				fmt.Printf("%d/%d bytes\n", transferred, total)
+			},
+		},
+		Size: info.Size(),
+	}
+
+	err = imagedata.UploadFrom(imageClient, imageID, imageData, uploadOpts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Resume Downloading Image Data to a File
+
+	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	imageData, err := os.OpenFile("/path/to/image/file", os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		panic(err)
+	}
+	defer imageData.Close()
+
+	info, err := imageData.Stat()
+	if err != nil {
+		panic(err)
+	}
+
+	downloadOpts := imagedata.DownloadOpts{
+		TransferOpts: imagedata.TransferOpts{
+			Context: ctx,
+			Retries: 5,
+		},
+		Offset: info.Size(),
+	}
+
+	_, err = imagedata.DownloadTo(imageClient, imageID, imageData, downloadOpts)
+	if err != nil {
+		panic(err)
+	}
 */
 package imagedata
@@ -0,0 +1,61 @@
+package imagedata
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrChecksumMismatch is the error when the checksum of the transferred image
+// data differs from the checksum recorded for the image.
+type ErrChecksumMismatch struct {
+	eclcloud.BaseError
+	ImageID   string
+	Algorithm string
+	Expected  string
+	Actual    string
+}
+
+func (e ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf("The %s checksum of the data of image %s is %s, expected %s",
+		e.Algorithm, e.ImageID, e.Actual, e.Expected)
+}
+
+// ErrUnsupportedHashAlgorithm is the error when the os_hash_algo of an image
+// is not one the transferred data can be verified with.
+type ErrUnsupportedHashAlgorithm struct {
+	eclcloud.BaseError
+	ImageID   string
+	Algorithm string
+}
+
+func (e ErrUnsupportedHashAlgorithm) Error() string {
+	return fmt.Sprintf("Unable to verify the data of image %s: unsupported os_hash_algo [%s]", e.ImageID, e.Algorithm)
+}
+
+// ErrUnverifiable is the error when the os_hash_value of an image cannot be
+// verified because the downloaded data cannot be read back from the
+// destination.
+type ErrUnverifiable struct {
+	eclcloud.BaseError
+	ImageID     string
+	Algorithm   string
+	Destination string
+}
+
+func (e ErrUnverifiable) Error() string {
+	return fmt.Sprintf("Unable to verify the %s checksum of the data of image %s: %s does not implement io.ReaderAt",
+		e.Algorithm, e.ImageID, e.Destination)
+}
+
+// ErrImageKilled is the error when the Image service has killed an image,
+// either while its data was uploaded, so that the upload is not retried, or
+// while it was imported with imageimport.
+type ErrImageKilled struct {
+	eclcloud.BaseError
+	ImageID string
+}
+
+func (e ErrImageKilled) Error() string {
+	return fmt.Sprintf("Image %s has been killed, its data cannot be uploaded or imported", e.ImageID)
+}
@@ -16,6 +16,17 @@ func Upload(client *eclcloud.ServiceClient, id string, data io.Reader) (r Upload
 	return
 }
 
+// Stage uploads image data to the staging area of an image in the "queued"
+// status. The staged data is imported into the image with the glance-direct
+// method of imageimport.Create.
+func Stage(client *eclcloud.ServiceClient, id string, data io.Reader) (r StageResult) {
+	_, r.Err = client.Put(stageURL(client, id), data, nil, &eclcloud.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/octet-stream"},
+		OkCodes:     []int{204},
+	})
+	return
+}
+
 // Download retrieves an image.
 func Download(client *eclcloud.ServiceClient, id string) (r DownloadResult) {
 	var resp *http.Response
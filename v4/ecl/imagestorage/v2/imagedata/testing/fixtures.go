@@ -1,8 +1,14 @@
 package testing
 
 import (
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"testing"
 
 	th "github.com/nttcom/eclcloud/v4/testhelper"
@@ -26,6 +32,24 @@ func HandlePutImageDataSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleStageImageDataSuccessfully setup
+func HandleStageImageDataSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/images/da3b75d9-3f4a-40e7-8a2c-bfab23927dea/stage", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/octet-stream")
+
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("Unable to read request body: %v", err)
+		}
+
+		th.AssertByteArrayEquals(t, []byte{5, 3, 7, 24}, b)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 // HandleGetImageDataSuccessfully setup
 func HandleGetImageDataSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/images/100f4d2d-dcb5-472e-b93f-b4e13d888604/file", func(w http.ResponseWriter, r *http.Request) {
@@ -38,3 +62,152 @@ func HandleGetImageDataSuccessfully(t *testing.T) {
 		th.AssertNoErr(t, err)
 	})
 }
+
+// TransferImageID is the ID of the image used by the UploadFrom and
+// DownloadTo tests.
+const TransferImageID = "7f3c9a2e-1b4d-4e6f-8a0c-2d5e7f9b1c3a"
+
+// TransferData is the image data used by the UploadFrom and DownloadTo tests.
+var TransferData = []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+
+// transferImageOutput returns a sample response to a Get request for
+// TransferImageID, with the given status and checksums.
+func transferImageOutput(status, checksum, hashAlgo, hashValue string) string {
+	return fmt.Sprintf(`
+{
+    "id": "%s",
+    "name": "transfer",
+    "status": "%s",
+    "container_format": "bare",
+    "disk_format": "raw",
+    "visibility": "private",
+    "size": %d,
+    "checksum": "%s",
+    "os_hash_algo": "%s",
+    "os_hash_value": "%s",
+    "created_at": "2019-02-06T08:06:57Z",
+    "updated_at": "2019-02-06T08:06:57Z",
+    "file": "/v2/images/%s/file",
+    "schema": "/v2/schemas/image"
+}`, TransferImageID, status, len(TransferData), checksum, hashAlgo, hashValue, TransferImageID)
+}
+
+// HandleGetTransferImageSuccessfully configures the test server to respond to
+// a Get request for TransferImageID with the checksums of TransferData.
+func HandleGetTransferImageSuccessfully(t *testing.T) {
+	sum := md5.Sum(TransferData)
+	hashValue := sha512.Sum512(TransferData)
+	HandleGetTransferImage(t, hex.EncodeToString(sum[:]), "sha512", hex.EncodeToString(hashValue[:]))
+}
+
+// HandleGetTransferImage configures the test server to respond to a Get
+// request for TransferImageID with the given checksums.
+func HandleGetTransferImage(t *testing.T, checksum, hashAlgo, hashValue string) {
+	th.Mux.HandleFunc("/images/"+TransferImageID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, transferImageOutput("active", checksum, hashAlgo, hashValue))
+	})
+}
+
+// HandleGetKilledTransferImage configures the test server to respond to a
+// Get request for TransferImageID with a killed image.
+func HandleGetKilledTransferImage(t *testing.T) {
+	th.Mux.HandleFunc("/images/"+TransferImageID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, transferImageOutput("killed", "", "", ""))
+	})
+}
+
+// HandleUploadTransferImage configures the test server to respond to upload
+// requests for TransferImageID. The first failures requests are answered with
+// 503. The returned counter is incremented on every request.
+func HandleUploadTransferImage(t *testing.T, failures int) *int {
+	calls := 0
+	th.Mux.HandleFunc("/images/"+TransferImageID+"/file", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/octet-stream")
+
+		calls++
+		b, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		th.AssertByteArrayEquals(t, TransferData, b)
+
+		if calls <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return &calls
+}
+
+// HandleDownloadTransferImage configures the test server to respond to
+// download requests for TransferImageID. The first response is cut off after
+// cutOff bytes, and Range requests are answered with 206 unless ignoreRange
+// is set. The returned slice records the Range header of every request.
+func HandleDownloadTransferImage(t *testing.T, cutOff int, ignoreRange bool) *[]string {
+	var ranges []string
+	th.Mux.HandleFunc("/images/"+TransferImageID+"/file", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		rangeHeader := r.Header.Get("Range")
+		ranges = append(ranges, rangeHeader)
+
+		data := TransferData
+		status := http.StatusOK
+		if rangeHeader != "" && !ignoreRange {
+			var start int
+			_, err := fmt.Sscanf(rangeHeader, "bytes=%d-", &start)
+			th.AssertNoErr(t, err)
+			data = data[start:]
+			status = http.StatusPartialContent
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(TransferData)-1, len(TransferData)))
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(status)
+
+		if len(ranges) == 1 && cutOff > 0 {
+			// Less data than announced makes the client see an unexpected
+			// EOF, like on a dropped connection.
+			w.Write(data[:cutOff])
+			return
+		}
+		w.Write(data)
+	})
+	return &ranges
+}
+
+// Buffer is an in-memory io.WriterAt and io.ReaderAt.
+type Buffer struct {
+	Data []byte
+}
+
+func (b *Buffer) WriteAt(p []byte, off int64) (int, error) {
+	if end := int(off) + len(p); end > len(b.Data) {
+		b.Data = append(b.Data, make([]byte, end-len(b.Data))...)
+	}
+	return copy(b.Data[off:], p), nil
+}
+
+func (b *Buffer) ReadAt(p []byte, off int64) (int, error) {
+	if off >= int64(len(b.Data)) {
+		return 0, io.EOF
+	}
+	n := copy(p, b.Data[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
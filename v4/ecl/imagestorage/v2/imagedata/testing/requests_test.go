@@ -1,11 +1,16 @@
 package testing
 
 import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"testing"
 
+	"github.com/nttcom/eclcloud/v4"
 	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/imagedata"
 	th "github.com/nttcom/eclcloud/v4/testhelper"
 	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
@@ -25,7 +30,6 @@ func TestUpload(t *testing.T) {
 	th.AssertNoErr(t, err)
 }
 
-/*
 func TestStage(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -39,7 +43,6 @@ func TestStage(t *testing.T) {
 
 	th.AssertNoErr(t, err)
 }
-*/
 
 func readSeekerOfBytes(bs []byte) io.ReadSeeker {
 	return &RS{bs: bs}
@@ -101,3 +104,202 @@ func TestDownload(t *testing.T) {
 
 	th.AssertByteArrayEquals(t, []byte{34, 87, 0, 23, 23, 23, 56, 255, 254, 0}, bs)
 }
+
+func TestUploadFrom(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := HandleUploadTransferImage(t, 1)
+	HandleGetTransferImageSuccessfully(t)
+
+	var progress []int64
+	opts := imagedata.UploadOpts{
+		TransferOpts: imagedata.TransferOpts{
+			Retries: 1,
+			Progress: func(transferred, total int64) {
+				th.AssertEquals(t, int64(len(TransferData)), total)
+				progress = append(progress, transferred)
+			},
+		},
+		Size: int64(len(TransferData)),
+	}
+
+	err := imagedata.UploadFrom(fakeclient.ServiceClient(), TransferImageID, bytes.NewReader(TransferData), opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, *calls)
+	th.AssertEquals(t, int64(len(TransferData)), progress[len(progress)-1])
+}
+
+func TestUploadFromRetriesExhausted(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := HandleUploadTransferImage(t, 3)
+	HandleGetTransferImageSuccessfully(t)
+
+	opts := imagedata.UploadOpts{
+		TransferOpts: imagedata.TransferOpts{Retries: 2},
+		Size:         int64(len(TransferData)),
+	}
+
+	err := imagedata.UploadFrom(fakeclient.ServiceClient(), TransferImageID, bytes.NewReader(TransferData), opts)
+	if _, ok := err.(eclcloud.ErrDefault503); !ok {
+		t.Fatalf("expected ErrDefault503, got %v", err)
+	}
+	th.AssertEquals(t, 3, *calls)
+}
+
+func TestUploadFromKilled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := HandleUploadTransferImage(t, 3)
+	HandleGetKilledTransferImage(t)
+
+	opts := imagedata.UploadOpts{
+		TransferOpts: imagedata.TransferOpts{Retries: 2},
+		Size:         int64(len(TransferData)),
+	}
+
+	err := imagedata.UploadFrom(fakeclient.ServiceClient(), TransferImageID, bytes.NewReader(TransferData), opts)
+	if _, ok := err.(imagedata.ErrImageKilled); !ok {
+		t.Fatalf("expected ErrImageKilled, got %v", err)
+	}
+	th.AssertEquals(t, 1, *calls)
+}
+
+func TestUploadFromUnsupportedHashAlgorithm(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUploadTransferImage(t, 0)
+	HandleGetTransferImage(t, "", "whirlpool", "0123")
+
+	opts := imagedata.UploadOpts{Size: int64(len(TransferData))}
+
+	err := imagedata.UploadFrom(fakeclient.ServiceClient(), TransferImageID, bytes.NewReader(TransferData), opts)
+	if _, ok := err.(imagedata.ErrUnsupportedHashAlgorithm); !ok {
+		t.Fatalf("expected ErrUnsupportedHashAlgorithm, got %v", err)
+	}
+}
+
+func TestUploadFromChecksumMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUploadTransferImage(t, 0)
+	HandleGetTransferImage(t, "d41d8cd98f00b204e9800998ecf8427e", "", "")
+
+	opts := imagedata.UploadOpts{Size: int64(len(TransferData))}
+
+	err := imagedata.UploadFrom(fakeclient.ServiceClient(), TransferImageID, bytes.NewReader(TransferData), opts)
+	mismatch, ok := err.(imagedata.ErrChecksumMismatch)
+	if !ok {
+		t.Fatalf("expected ErrChecksumMismatch, got %v", err)
+	}
+	th.AssertEquals(t, "md5", mismatch.Algorithm)
+	th.AssertEquals(t, "d41d8cd98f00b204e9800998ecf8427e", mismatch.Expected)
+}
+
+func TestUploadFromCancelled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	opts := imagedata.UploadOpts{
+		TransferOpts: imagedata.TransferOpts{Context: ctx, Retries: 3},
+		Size:         int64(len(TransferData)),
+	}
+
+	err := imagedata.UploadFrom(fakeclient.ServiceClient(), TransferImageID, bytes.NewReader(TransferData), opts)
+	th.AssertEquals(t, context.Canceled, err)
+}
+
+func TestDownloadToResumes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTransferImageSuccessfully(t)
+	ranges := HandleDownloadTransferImage(t, 10, false)
+
+	var dst Buffer
+	opts := imagedata.DownloadOpts{
+		TransferOpts: imagedata.TransferOpts{Retries: 1},
+	}
+
+	n, err := imagedata.DownloadTo(fakeclient.ServiceClient(), TransferImageID, &dst, opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(len(TransferData)), n)
+	th.AssertByteArrayEquals(t, TransferData, dst.Data)
+	th.AssertDeepEquals(t, []string{"", "bytes=10-"}, *ranges)
+}
+
+func TestDownloadToRangeIgnored(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTransferImageSuccessfully(t)
+	ranges := HandleDownloadTransferImage(t, 10, true)
+
+	var dst Buffer
+	opts := imagedata.DownloadOpts{
+		TransferOpts: imagedata.TransferOpts{Retries: 1},
+	}
+
+	n, err := imagedata.DownloadTo(fakeclient.ServiceClient(), TransferImageID, &dst, opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(len(TransferData)), n)
+	th.AssertByteArrayEquals(t, TransferData, dst.Data)
+	th.AssertDeepEquals(t, []string{"", "bytes=10-"}, *ranges)
+}
+
+func TestDownloadToFromOffset(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTransferImageSuccessfully(t)
+	ranges := HandleDownloadTransferImage(t, 0, false)
+
+	dst := Buffer{Data: append([]byte(nil), TransferData[:20]...)}
+	opts := imagedata.DownloadOpts{Offset: 20}
+
+	n, err := imagedata.DownloadTo(fakeclient.ServiceClient(), TransferImageID, &dst, opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(len(TransferData)), n)
+	th.AssertByteArrayEquals(t, TransferData, dst.Data)
+	th.AssertDeepEquals(t, []string{"bytes=20-"}, *ranges)
+}
+
+func TestDownloadToChecksumMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	sum := md5.Sum(TransferData)
+	HandleGetTransferImage(t, hex.EncodeToString(sum[:]), "sha256", "0000")
+	HandleDownloadTransferImage(t, 0, false)
+
+	var dst Buffer
+	_, err := imagedata.DownloadTo(fakeclient.ServiceClient(), TransferImageID, &dst, imagedata.DownloadOpts{})
+	mismatch, ok := err.(imagedata.ErrChecksumMismatch)
+	if !ok {
+		t.Fatalf("expected ErrChecksumMismatch, got %v", err)
+	}
+	th.AssertEquals(t, "sha256", mismatch.Algorithm)
+}
+
+// writerAt hides the io.ReaderAt of a Buffer.
+type writerAt struct {
+	b *Buffer
+}
+
+func (w writerAt) WriteAt(p []byte, off int64) (int, error) {
+	return w.b.WriteAt(p, off)
+}
+
+func TestDownloadToUnverifiable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTransferImageSuccessfully(t)
+	HandleDownloadTransferImage(t, 0, false)
+
+	var dst Buffer
+	_, err := imagedata.DownloadTo(fakeclient.ServiceClient(), TransferImageID, writerAt{&dst}, imagedata.DownloadOpts{})
+	unverifiable, ok := err.(imagedata.ErrUnverifiable)
+	if !ok {
+		t.Fatalf("expected ErrUnverifiable, got %v", err)
+	}
+	th.AssertEquals(t, "sha512", unverifiable.Algorithm)
+}
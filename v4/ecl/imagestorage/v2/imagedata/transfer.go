@@ -0,0 +1,382 @@
+package imagedata
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/images"
+)
+
+// transferBufferSize is the size of the buffer image data is copied with.
+const transferBufferSize = 1 << 20
+
+// ProgressFunc is called while image data is transferred with the number of
+// bytes transferred so far and the total number of bytes, if known.
+type ProgressFunc func(transferred, total int64)
+
+// TransferOpts contains the options common to UploadFrom and DownloadTo.
+type TransferOpts struct {
+	// Context, if provided, cancels the transfer when it is done.
+	Context context.Context
+
+	// Retries is the number of times a failed request is retried. Requests
+	// are not retried on client errors other than 408 and 429.
+	Retries int
+
+	// RetryInterval is the time waited before a failed request is retried.
+	RetryInterval time.Duration
+
+	// Progress, if provided, is called after every chunk of image data
+	// transferred.
+	Progress ProgressFunc
+}
+
+// UploadOpts contains options for UploadFrom.
+type UploadOpts struct {
+	TransferOpts
+
+	// Size is the number of bytes of image data to upload from the source.
+	Size int64
+}
+
+// UploadFrom uploads Size bytes of image data read from src, and verifies the
+// checksums the Image service records for the image afterwards.
+//
+// The Image service does not accept partial image data, so an interrupted
+// upload is retried from the start of src, up to Retries times. Before each
+// retry the image is read, and an ErrImageKilled is returned instead if the
+// Image service has killed it. The MD5
+// checksum and, if the image has one, the os_hash_value are verified. An
+// ErrChecksumMismatch is returned if either differs.
+func UploadFrom(client *eclcloud.ServiceClient, id string, src io.ReaderAt, opts UploadOpts) error {
+	if opts.Size <= 0 {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "imagedata.UploadOpts.Size"
+		err.Value = opts.Size
+		err.Info = "Size must be greater than 0"
+		return err
+	}
+	ctx := opts.context()
+
+	var body *transferReader
+	attempts := 0
+	err := opts.retry(ctx, func() error {
+		if attempts > 0 {
+			image, err := images.Get(client, id).Extract()
+			if err != nil {
+				return err
+			}
+			if image.Status == images.ImageStatusKilled {
+				return ErrImageKilled{ImageID: id}
+			}
+		}
+		attempts++
+
+		body = &transferReader{
+			ctx:      ctx,
+			r:        io.NewSectionReader(src, 0, opts.Size),
+			hash:     md5.New(),
+			total:    opts.Size,
+			progress: opts.Progress,
+		}
+		_, err := client.Put(uploadURL(client, id), nil, nil, &eclcloud.RequestOpts{
+			RawBody:     body,
+			MoreHeaders: map[string]string{"Content-Type": "application/octet-stream"},
+			OkCodes:     []int{204},
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	image, err := images.Get(client, id).Extract()
+	if err != nil {
+		return err
+	}
+
+	return verify(image, body.hash, func(h hash.Hash) error {
+		_, err := io.Copy(h, io.NewSectionReader(src, 0, opts.Size))
+		return err
+	})
+}
+
+// DownloadOpts contains options for DownloadTo.
+type DownloadOpts struct {
+	TransferOpts
+
+	// Offset is the number of bytes of image data already written to the
+	// destination by an earlier, interrupted download. The download resumes
+	// after them. The destination must also implement io.ReaderAt, so that
+	// they can be included in the checksums.
+	Offset int64
+}
+
+// DownloadTo downloads the data of an image to dst, and verifies it against
+// the checksums the Image service records for the image. It returns the
+// number of bytes of image data in dst.
+//
+// An interrupted download is resumed with a Range request from the last byte
+// written, up to Retries times in a row. The MD5 checksum and, if the image
+// has one, the os_hash_value are verified. An ErrChecksumMismatch is returned
+// if either differs.
+func DownloadTo(client *eclcloud.ServiceClient, id string, dst io.WriterAt, opts DownloadOpts) (int64, error) {
+	ctx := opts.context()
+
+	image, err := images.Get(client, id).Extract()
+	if err != nil {
+		return 0, err
+	}
+
+	w := &transferWriter{
+		ctx:      ctx,
+		w:        dst,
+		hash:     md5.New(),
+		total:    image.SizeBytes,
+		progress: opts.Progress,
+	}
+
+	if opts.Offset > 0 {
+		r, ok := dst.(io.ReaderAt)
+		if !ok {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "imagedata.DownloadOpts.Offset"
+			err.Value = opts.Offset
+			err.Info = fmt.Sprintf("Resuming a download requires a destination which implements io.ReaderAt, got %T", dst)
+			return 0, err
+		}
+		if _, err := io.Copy(w.hash, io.NewSectionReader(r, 0, opts.Offset)); err != nil {
+			return 0, err
+		}
+		w.offset = opts.Offset
+	}
+
+	err = opts.retry(ctx, func() error {
+		if image.SizeBytes > 0 && w.offset >= image.SizeBytes {
+			return nil
+		}
+
+		reqOpts := &eclcloud.RequestOpts{
+			OkCodes: []int{200, 206},
+		}
+		if w.offset > 0 {
+			reqOpts.MoreHeaders = map[string]string{"Range": fmt.Sprintf("bytes=%d-", w.offset)}
+		}
+		resp, err := client.Get(downloadURL(client, id), nil, reqOpts)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		// Unblock a stalled read once ctx is done.
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			select {
+			case <-ctx.Done():
+				resp.Body.Close()
+			case <-done:
+			}
+		}()
+
+		// The whole image data is sent if the Range header was ignored.
+		if resp.StatusCode == http.StatusOK && w.offset > 0 {
+			w.reset()
+		}
+
+		if _, err := io.CopyBuffer(w, resp.Body, make([]byte, transferBufferSize)); err != nil {
+			return err
+		}
+		if image.SizeBytes > 0 && w.offset < image.SizeBytes {
+			return io.ErrUnexpectedEOF
+		}
+		return nil
+	})
+	if err != nil {
+		return w.offset, err
+	}
+
+	err = verify(image, w.hash, func(h hash.Hash) error {
+		r, ok := dst.(io.ReaderAt)
+		if !ok {
+			algo, _ := image.Properties["os_hash_algo"].(string)
+			return ErrUnverifiable{ImageID: image.ID, Algorithm: algo, Destination: fmt.Sprintf("%T", dst)}
+		}
+		_, err := io.Copy(h, io.NewSectionReader(r, 0, w.offset))
+		return err
+	})
+	return w.offset, err
+}
+
+func (opts TransferOpts) context() context.Context {
+	if opts.Context == nil {
+		return context.Background()
+	}
+	return opts.Context
+}
+
+// retry calls f until it succeeds, fails with an error which is not worth
+// retrying, or has failed more than opts.Retries times in a row.
+func (opts TransferOpts) retry(ctx context.Context, f func() error) error {
+	for failures := 0; ; failures++ {
+		err := f()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err == nil || failures >= opts.Retries || !isRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(opts.RetryInterval):
+		}
+	}
+}
+
+// isRetryable reports whether a request which failed with err may succeed
+// when it is sent again.
+func isRetryable(err error) bool {
+	switch e := err.(type) {
+	case eclcloud.ErrDefault408, eclcloud.ErrDefault429, eclcloud.ErrDefault500, eclcloud.ErrDefault503:
+		return true
+	case eclcloud.ErrDefault400, eclcloud.ErrDefault401, eclcloud.ErrDefault403, eclcloud.ErrDefault404,
+		eclcloud.ErrDefault405, eclcloud.ErrDefault409, eclcloud.ErrInvalidInput, ErrImageKilled:
+		return false
+	case eclcloud.ErrUnexpectedResponseCode:
+		return e.Actual >= 500
+	case *eclcloud.ErrUnableToReauthenticate, *eclcloud.ErrErrorAfterReauthentication:
+		return false
+	}
+	return true
+}
+
+// verify compares the checksums of image with the MD5 checksum of the
+// transferred data in md5Hash. If the os_hash_value of the image has been
+// calculated with an algorithm other than MD5, rehash is called to calculate
+// it from the transferred data.
+func verify(image *images.Image, md5Hash hash.Hash, rehash func(hash.Hash) error) error {
+	if image.Checksum != "" {
+		actual := hex.EncodeToString(md5Hash.Sum(nil))
+		if actual != image.Checksum {
+			return ErrChecksumMismatch{ImageID: image.ID, Algorithm: "md5", Expected: image.Checksum, Actual: actual}
+		}
+	}
+
+	algo, _ := image.Properties["os_hash_algo"].(string)
+	expected, _ := image.Properties["os_hash_value"].(string)
+	if algo == "" || expected == "" {
+		return nil
+	}
+
+	var h hash.Hash
+	switch algo {
+	case "md5":
+		h = md5Hash
+	case "sha1":
+		h = sha1.New()
+	case "sha256":
+		h = sha256.New()
+	case "sha384":
+		h = sha512.New384()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return ErrUnsupportedHashAlgorithm{ImageID: image.ID, Algorithm: algo}
+	}
+	if h != md5Hash {
+		if err := rehash(h); err != nil {
+			return err
+		}
+	}
+
+	actual := hex.EncodeToString(h.Sum(nil))
+	if actual != expected {
+		return ErrChecksumMismatch{ImageID: image.ID, Algorithm: algo, Expected: expected, Actual: actual}
+	}
+	return nil
+}
+
+// transferReader reads the image data to upload, hashing it and reporting
+// progress. It stops with the error of ctx once ctx is done.
+type transferReader struct {
+	ctx         context.Context
+	r           *io.SectionReader
+	hash        hash.Hash
+	transferred int64
+	total       int64
+	progress    ProgressFunc
+}
+
+func (r *transferReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.hash.Write(p[:n])
+		r.transferred += int64(n)
+		if r.progress != nil {
+			r.progress(r.transferred, r.total)
+		}
+	}
+	return n, err
+}
+
+// Seek allows the request to be sent again after reauthentication. Only
+// seeking to the start is supported.
+func (r *transferReader) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, fmt.Errorf("imagedata: unsupported seek to %d from %d", offset, whence)
+	}
+	r.hash.Reset()
+	r.transferred = 0
+	return r.r.Seek(0, io.SeekStart)
+}
+
+// String keeps the image data out of the request log.
+func (r *transferReader) String() string {
+	return fmt.Sprintf("<%d bytes of image data>", r.total)
+}
+
+// transferWriter writes downloaded image data at increasing offsets, hashing
+// it and reporting progress. It stops with the error of ctx once ctx is done.
+type transferWriter struct {
+	ctx      context.Context
+	w        io.WriterAt
+	hash     hash.Hash
+	offset   int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (w *transferWriter) Write(p []byte) (int, error) {
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := w.w.WriteAt(p, w.offset)
+	if n > 0 {
+		w.hash.Write(p[:n])
+		w.offset += int64(n)
+		if w.progress != nil {
+			w.progress(w.offset, w.total)
+		}
+	}
+	return n, err
+}
+
+func (w *transferWriter) reset() {
+	w.hash.Reset()
+	w.offset = 0
+}
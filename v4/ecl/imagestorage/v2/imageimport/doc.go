@@ -0,0 +1,52 @@
+/*
+Package imageimport enables the interoperable image import workflow of the
+Enterprise Cloud Image service.
+
+Instead of uploading image data with imagedata.Upload, the data of an image in
+the "queued" status is imported with one of the import methods the service
+offers: glance-direct imports data staged with imagedata.Stage, web-download
+lets the service download the data from a URL itself.
+
+Example to Get the Available Import Methods
+
+	importInfo, err := imageimport.Get(imageClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", importInfo.ImportMethods.Value)
+
+Example to Import Image Data from a URL
+
+	createOpts := imageimport.CreateOpts{
+		Name: imageimport.WebDownloadMethod,
+		URI:  "https://example.com/images/centos.qcow2",
+	}
+
+	err := imageimport.Create(imageClient, imageID, createOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = imageimport.WaitForImport(imageClient, imageID, 3600)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Import Staged Image Data
+
+	err := imagedata.Stage(imageClient, imageID, imageData).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := imageimport.CreateOpts{
+		Name: imageimport.GlanceDirectMethod,
+	}
+
+	err = imageimport.Create(imageClient, imageID, createOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package imageimport
@@ -0,0 +1,94 @@
+package imageimport
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ImportMethod represents valid Import API method.
+type ImportMethod string
+
+const (
+	// GlanceDirectMethod represents glance-direct Import API method.
+	GlanceDirectMethod ImportMethod = "glance-direct"
+
+	// WebDownloadMethod represents web-download Import API method.
+	WebDownloadMethod ImportMethod = "web-download"
+)
+
+// Get retrieves Import API information data.
+func Get(c *eclcloud.ServiceClient) (r GetResult) {
+	_, r.Err = c.Get(infoURL(c), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToImportCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new image import.
+type CreateOpts struct {
+	// Name is the import method.
+	Name ImportMethod `json:"name" required:"true"`
+
+	// URI is the URL the image data is downloaded from. It is required by,
+	// and only used with, the web-download method.
+	URI string `json:"uri,omitempty"`
+}
+
+// ToImportCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToImportCreateMap() (map[string]interface{}, error) {
+	switch opts.Name {
+	case GlanceDirectMethod:
+		if opts.URI != "" {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "imageimport.CreateOpts.URI"
+			err.Value = opts.URI
+			err.Info = fmt.Sprintf("URI can only be used with the %s method", WebDownloadMethod)
+			return nil, err
+		}
+	case WebDownloadMethod:
+		u, err := url.Parse(opts.URI)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "imageimport.CreateOpts.URI"
+			err.Value = opts.URI
+			err.Info = fmt.Sprintf("The %s method requires an http or https URI", WebDownloadMethod)
+			return nil, err
+		}
+	case "":
+		err := eclcloud.ErrMissingInput{}
+		err.Argument = "imageimport.CreateOpts.Name"
+		return nil, err
+	default:
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "imageimport.CreateOpts.Name"
+		err.Value = opts.Name
+		err.Info = fmt.Sprintf("Name must be %s or %s", GlanceDirectMethod, WebDownloadMethod)
+		return nil, err
+	}
+
+	b, err := eclcloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"method": b}, nil
+}
+
+// Create requests the import of the image data of an image with the given
+// import method.
+func Create(client *eclcloud.ServiceClient, imageID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToImportCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(importURL(client, imageID), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,2 @@
+// Package testing contains imageimport unit tests
+package testing
@@ -0,0 +1,108 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/imageimport"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/images"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+// ImageID is the ID of the image used in the tests.
+const ImageID = "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+// ImportGetResult represents raw server response on a GET request.
+const ImportGetResult = `
+{
+    "import-methods": {
+        "description": "Import methods available.",
+        "type": "array",
+        "value": [
+            "glance-direct",
+            "web-download"
+        ]
+    }
+}
+`
+
+// ExpectedImportInfo represents the ImportInfo in ImportGetResult.
+var ExpectedImportInfo = imageimport.ImportInfo{
+	ImportMethods: imageimport.ImportMethods{
+		Description: "Import methods available.",
+		Type:        "array",
+		Value:       []string{"glance-direct", "web-download"},
+	},
+}
+
+// ImportCreateRequest represents a request to import image data with the
+// web-download method.
+const ImportCreateRequest = `
+{
+    "method": {
+        "name": "web-download",
+        "uri": "https://example.com/images/ubuntu.qcow2"
+    }
+}
+`
+
+// imageGetResult returns a minimal image response with the given status.
+func imageGetResult(status images.ImageStatus) string {
+	return fmt.Sprintf(`
+{
+    "id": "%s",
+    "name": "ubuntu",
+    "status": "%s",
+    "visibility": "private",
+    "disk_format": "qcow2",
+    "container_format": "bare"
+}
+`, ImageID, status)
+}
+
+// HandleImportInfoGetSuccessfully configures the test server to respond to a
+// Get request.
+func HandleImportInfoGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/info/import", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ImportGetResult)
+	})
+}
+
+// HandleImportCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleImportCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/images/"+ImageID+"/import", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, ImportCreateRequest)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleImageGet configures the test server to respond to image Get requests
+// with the given statuses in turn, repeating the last one.
+func HandleImageGet(t *testing.T, statuses ...images.ImageStatus) {
+	calls := 0
+	th.Mux.HandleFunc("/images/"+ImageID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, imageGetResult(status))
+	})
+}
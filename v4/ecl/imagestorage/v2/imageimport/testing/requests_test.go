@@ -0,0 +1,83 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/imagedata"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/imageimport"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/images"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestGetImportInfo(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleImportInfoGetSuccessfully(t)
+
+	actual, err := imageimport.Get(fakeclient.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedImportInfo, *actual)
+	th.AssertEquals(t, true, actual.Supports(imageimport.WebDownloadMethod))
+	th.AssertEquals(t, false, actual.Supports(imageimport.ImportMethod("copy-image")))
+}
+
+func TestCreateImport(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleImportCreateSuccessfully(t)
+
+	opts := imageimport.CreateOpts{
+		Name: imageimport.WebDownloadMethod,
+		URI:  "https://example.com/images/ubuntu.qcow2",
+	}
+	err := imageimport.Create(fakeclient.ServiceClient(), ImageID, opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateImportInvalidOpts(t *testing.T) {
+	cases := []imageimport.CreateOpts{
+		{},
+		{Name: imageimport.GlanceDirectMethod, URI: "https://example.com/images/ubuntu.qcow2"},
+		{Name: imageimport.WebDownloadMethod},
+		{Name: imageimport.WebDownloadMethod, URI: "file:///etc/passwd"},
+	}
+
+	for _, opts := range cases {
+		err := imageimport.Create(fakeclient.ServiceClient(), ImageID, opts).ExtractErr()
+		if err == nil {
+			t.Errorf("Expected an error for %+v", opts)
+		}
+	}
+
+	_, err := imageimport.CreateOpts{}.ToImportCreateMap()
+	if _, ok := err.(eclcloud.ErrMissingInput); !ok {
+		t.Errorf("Expected ErrMissingInput, got %T", err)
+	}
+
+	_, err = imageimport.CreateOpts{Name: imageimport.ImportMethod("copy-image")}.ToImportCreateMap()
+	if _, ok := err.(eclcloud.ErrInvalidInput); !ok {
+		t.Errorf("Expected ErrInvalidInput, got %T", err)
+	}
+}
+
+func TestWaitForImport(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleImageGet(t, images.ImageStatusImporting, images.ImageStatusActive)
+
+	err := imageimport.WaitForImport(fakeclient.ServiceClient(), ImageID, 5)
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForImportKilled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleImageGet(t, images.ImageStatusKilled)
+
+	err := imageimport.WaitForImport(fakeclient.ServiceClient(), ImageID, 5)
+	if _, ok := err.(imagedata.ErrImageKilled); !ok {
+		t.Fatalf("Expected ErrImageKilled, got %#v", err)
+	}
+}
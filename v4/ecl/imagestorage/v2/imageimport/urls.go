@@ -0,0 +1,17 @@
+package imageimport
+
+import "github.com/nttcom/eclcloud/v4"
+
+const (
+	rootPath     = "images"
+	infoPath     = "info"
+	resourcePath = "import"
+)
+
+func infoURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL(infoPath, resourcePath)
+}
+
+func importURL(c *eclcloud.ServiceClient, imageID string) string {
+	return c.ServiceURL(rootPath, imageID, resourcePath)
+}
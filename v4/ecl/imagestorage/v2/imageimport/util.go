@@ -0,0 +1,29 @@
+package imageimport
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/imagedata"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/images"
+)
+
+// WaitForImport will continually poll an image until its data has been
+// imported and it is active. An imagedata.ErrImageKilled is returned if the
+// import failed and the image has been killed. It will do this for at most the
+// number of seconds specified.
+func WaitForImport(c *eclcloud.ServiceClient, imageID string, secs int) error {
+	return eclcloud.WaitFor(secs, func() (bool, error) {
+		current, err := images.Get(c, imageID).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		switch current.Status {
+		case images.ImageStatusActive:
+			return true, nil
+		case images.ImageStatusKilled:
+			return false, imagedata.ErrImageKilled{ImageID: imageID}
+		}
+
+		return false, nil
+	})
+}
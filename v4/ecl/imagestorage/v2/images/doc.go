@@ -49,6 +49,60 @@ Example to Update an Image
 		panic(err)
 	}
 
+Example to Create an Image with Validated Well-Known Properties
+
+	schema, err := images.GetSchema(imageClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	known := images.KnownProperties{
+		OSType:     images.OSTypeLinux,
+		HWDiskBus:  images.DiskBusSCSI,
+		HWVifModel: images.VifModelVirtio,
+	}
+
+	properties := known.ToPropertiesMap()
+	if err := schema.ValidateProperties(properties); err != nil {
+		panic(err)
+	}
+
+	createOpts := images.CreateOpts{
+		Name:       "image_name",
+		Properties: properties,
+	}
+
+	image, err := images.Create(imageClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update the Properties of an Image to a Desired Set
+
+	imageID := "1bea47ed-f6a9-463b-b423-14b9cca9ad27"
+
+	image, err := images.Get(imageClient, imageID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	desired := map[string]string{
+		"os_type":     "linux",
+		"hw_disk_bus": "virtio",
+	}
+
+	updateOpts := images.DiffProperties(image.Properties, desired)
+	if err := schema.ValidateUpdateOpts(updateOpts); err != nil {
+		panic(err)
+	}
+
+	if len(updateOpts) > 0 {
+		image, err = images.Update(imageClient, imageID, updateOpts).Extract()
+		if err != nil {
+			panic(err)
+		}
+	}
+
 Example to Delete an Image
 
 	imageID := "1bea47ed-f6a9-463b-b423-14b9cca9ad27"
@@ -0,0 +1,206 @@
+package images
+
+import (
+	"sort"
+	"strings"
+)
+
+// Well-known image property names.
+const (
+	PropertyArchitecture     = "architecture"
+	PropertyOSType           = "os_type"
+	PropertyOSDistro         = "os_distro"
+	PropertyOSVersion        = "os_version"
+	PropertyHWDiskBus        = "hw_disk_bus"
+	PropertyHWSCSIModel      = "hw_scsi_model"
+	PropertyHWVifModel       = "hw_vif_model"
+	PropertyHWQemuGuestAgent = "hw_qemu_guest_agent"
+	PropertyHWFirmwareType   = "hw_firmware_type"
+)
+
+// OSType is the operating system type of an image.
+type OSType string
+
+const (
+	OSTypeLinux   OSType = "linux"
+	OSTypeWindows OSType = "windows"
+)
+
+// DiskBus is the bus disks are attached to an instance with.
+type DiskBus string
+
+const (
+	DiskBusVirtio DiskBus = "virtio"
+	DiskBusSCSI   DiskBus = "scsi"
+	DiskBusIDE    DiskBus = "ide"
+	DiskBusSATA   DiskBus = "sata"
+	DiskBusUSB    DiskBus = "usb"
+)
+
+// VifModel is the model of the virtual network interfaces of an instance.
+type VifModel string
+
+const (
+	VifModelVirtio  VifModel = "virtio"
+	VifModelE1000   VifModel = "e1000"
+	VifModelE1000e  VifModel = "e1000e"
+	VifModelRTL8139 VifModel = "rtl8139"
+	VifModelNE2KPCI VifModel = "ne2k_pci"
+	VifModelPCNet   VifModel = "pcnet"
+	VifModelVMXNet3 VifModel = "vmxnet3"
+)
+
+// FirmwareType is the firmware an instance boots with.
+type FirmwareType string
+
+const (
+	FirmwareTypeBIOS FirmwareType = "bios"
+	FirmwareTypeUEFI FirmwareType = "uefi"
+)
+
+// KnownProperties are the well-known properties of an image. An empty field
+// means that the property is not set.
+type KnownProperties struct {
+	Architecture   string
+	OSType         OSType
+	OSDistro       string
+	OSVersion      string
+	HWDiskBus      DiskBus
+	HWSCSIModel    string
+	HWVifModel     VifModel
+	HWFirmwareType FirmwareType
+
+	// HWQemuGuestAgent is whether the QEMU guest agent is enabled. It is
+	// stored as "yes" or "no".
+	HWQemuGuestAgent *bool
+}
+
+// KnownProperties returns the well-known properties of the image.
+func (r Image) KnownProperties() KnownProperties {
+	p := KnownProperties{
+		Architecture:   r.stringProperty(PropertyArchitecture),
+		OSType:         OSType(r.stringProperty(PropertyOSType)),
+		OSDistro:       r.stringProperty(PropertyOSDistro),
+		OSVersion:      r.stringProperty(PropertyOSVersion),
+		HWDiskBus:      DiskBus(r.stringProperty(PropertyHWDiskBus)),
+		HWSCSIModel:    r.stringProperty(PropertyHWSCSIModel),
+		HWVifModel:     VifModel(r.stringProperty(PropertyHWVifModel)),
+		HWFirmwareType: FirmwareType(r.stringProperty(PropertyHWFirmwareType)),
+	}
+
+	switch strings.ToLower(r.stringProperty(PropertyHWQemuGuestAgent)) {
+	case "yes", "true":
+		enabled := true
+		p.HWQemuGuestAgent = &enabled
+	case "no", "false":
+		enabled := false
+		p.HWQemuGuestAgent = &enabled
+	}
+
+	return p
+}
+
+func (r Image) stringProperty(name string) string {
+	v, _ := r.Properties[name].(string)
+	return v
+}
+
+// ToPropertiesMap returns the properties which are set, in the form used by
+// CreateOpts.Properties and DiffProperties.
+func (p KnownProperties) ToPropertiesMap() map[string]string {
+	m := make(map[string]string)
+	set := func(name, value string) {
+		if value != "" {
+			m[name] = value
+		}
+	}
+
+	set(PropertyArchitecture, p.Architecture)
+	set(PropertyOSType, string(p.OSType))
+	set(PropertyOSDistro, p.OSDistro)
+	set(PropertyOSVersion, p.OSVersion)
+	set(PropertyHWDiskBus, string(p.HWDiskBus))
+	set(PropertyHWSCSIModel, p.HWSCSIModel)
+	set(PropertyHWVifModel, string(p.HWVifModel))
+	set(PropertyHWFirmwareType, string(p.HWFirmwareType))
+
+	if p.HWQemuGuestAgent != nil {
+		if *p.HWQemuGuestAgent {
+			m[PropertyHWQemuGuestAgent] = "yes"
+		} else {
+			m[PropertyHWQemuGuestAgent] = "no"
+		}
+	}
+
+	return m
+}
+
+// ReadOnlyProperties are properties set by the Image service. DiffProperties
+// never removes them, and they cannot be copied to another image.
+var ReadOnlyProperties = map[string]bool{
+	"os_hash_algo":  true,
+	"os_hash_value": true,
+	"direct_url":    true,
+	"locations":     true,
+	"stores":        true,
+}
+
+// DiffProperties returns the UpdateOpts which change the properties of an
+// image from current, usually the Properties of the Image, to desired, with
+// as few operations as possible.
+//
+// Properties in desired which are missing from current are added, and those
+// whose value differs are replaced. String properties in current which are
+// missing from desired are removed, except for those set by the Image
+// service. The operations are ordered by property name.
+func DiffProperties(current map[string]interface{}, desired map[string]string) UpdateOpts {
+	names := make([]string, 0, len(current)+len(desired))
+	for name := range desired {
+		names = append(names, name)
+	}
+	for name, v := range current {
+		if _, ok := desired[name]; !ok {
+			if _, ok := v.(string); ok && !ReadOnlyProperties[name] {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	opts := UpdateOpts{}
+	for _, name := range names {
+		value, wanted := desired[name]
+		old, exists := current[name]
+
+		switch {
+		case !wanted:
+			opts = append(opts, propertyPatch{UpdateImageProperty{Op: RemoveOp, Name: escapePointer(name)}})
+		case !exists:
+			opts = append(opts, propertyPatch{UpdateImageProperty{Op: AddOp, Name: escapePointer(name), Value: value}})
+		case old != value:
+			opts = append(opts, propertyPatch{UpdateImageProperty{Op: ReplaceOp, Name: escapePointer(name), Value: value}})
+		}
+	}
+
+	return opts
+}
+
+// propertyPatch is an UpdateImageProperty which sends its value even if it is
+// empty, so that DiffProperties can set a property to the empty string.
+type propertyPatch struct {
+	UpdateImageProperty
+}
+
+// ToImagePatchMap assembles a request body based on propertyPatch.
+func (r propertyPatch) ToImagePatchMap() map[string]interface{} {
+	updateMap := r.UpdateImageProperty.ToImagePatchMap()
+	if r.Op != RemoveOp {
+		updateMap["value"] = r.Value
+	}
+	return updateMap
+}
+
+// escapePointer escapes a property name for use in a JSON Pointer.
+func escapePointer(name string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
@@ -220,6 +220,13 @@ func Get(client *eclcloud.ServiceClient, id string) (r GetResult) {
 	return
 }
 
+// GetSchema retrieves the JSON schema of images, which can be used to
+// validate image properties before an image is created or updated.
+func GetSchema(client *eclcloud.ServiceClient) (r SchemaResult) {
+	_, r.Err = client.Get(schemaURL(client), &r.Body, nil)
+	return
+}
+
 // Update implements image updated request.
 func Update(client *eclcloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
 	b, err := opts.ToImageUpdateMap()
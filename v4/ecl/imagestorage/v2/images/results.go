@@ -160,6 +160,19 @@ type DeleteResult struct {
 	eclcloud.ErrResult
 }
 
+// SchemaResult represents the result of a GetSchema operation. Call its
+// Extract method to interpret it as a Schema.
+type SchemaResult struct {
+	eclcloud.Result
+}
+
+// Extract interprets a SchemaResult as a Schema.
+func (r SchemaResult) Extract() (*Schema, error) {
+	var s *Schema
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
 // ImagePage represents the results of a List request.
 type ImagePage struct {
 	serviceURL string
@@ -0,0 +1,240 @@
+package images
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// Schema is the JSON schema of images.
+type Schema struct {
+	// Name is the name of the schema.
+	Name string `json:"name"`
+
+	// Properties are the schemas of the image attributes and properties
+	// known to the Image service.
+	Properties map[string]SchemaProperty `json:"properties"`
+
+	// AdditionalProperties is the schema of all other properties. It is nil
+	// if no other properties are allowed.
+	AdditionalProperties *SchemaProperty `json:"-"`
+}
+
+func (r *Schema) UnmarshalJSON(b []byte) error {
+	type tmp Schema
+	var s struct {
+		tmp
+		AdditionalProperties json.RawMessage `json:"additionalProperties"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Schema(s.tmp)
+
+	switch string(s.AdditionalProperties) {
+	case "", "false":
+		r.AdditionalProperties = nil
+	case "true":
+		r.AdditionalProperties = &SchemaProperty{}
+	default:
+		err = json.Unmarshal(s.AdditionalProperties, &r.AdditionalProperties)
+	}
+
+	return err
+}
+
+// SchemaProperty is the schema of a single image attribute or property.
+type SchemaProperty struct {
+	// Type contains the JSON types the property may have. It is empty if
+	// any type is allowed.
+	Type []string `json:"-"`
+
+	// Description describes the property.
+	Description string `json:"description"`
+
+	// Enum, if not empty, contains the values the property may have.
+	Enum []interface{} `json:"enum"`
+
+	// MaxLength, if not nil, is the maximum length of the property.
+	MaxLength *int `json:"maxLength"`
+
+	// Pattern, if not empty, is a regular expression the property must
+	// match.
+	Pattern string `json:"pattern"`
+
+	// ReadOnly is whether the property is set by the Image service only.
+	ReadOnly bool `json:"readOnly"`
+}
+
+func (r *SchemaProperty) UnmarshalJSON(b []byte) error {
+	type tmp SchemaProperty
+	var s struct {
+		tmp
+		Type interface{} `json:"type"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = SchemaProperty(s.tmp)
+
+	switch t := s.Type.(type) {
+	case nil:
+		r.Type = nil
+	case string:
+		r.Type = []string{t}
+	case []interface{}:
+		r.Type = make([]string, 0, len(t))
+		for _, v := range t {
+			name, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("unknown type in schema property type: %v", v)
+			}
+			r.Type = append(r.Type, name)
+		}
+	default:
+		return fmt.Errorf("unknown type for schema property type: %T (value: %v)", t, t)
+	}
+
+	return nil
+}
+
+// validate checks a string value of the property against its schema.
+func (r SchemaProperty) validate(value string) string {
+	if r.ReadOnly {
+		return "The property is read-only"
+	}
+
+	if len(r.Type) > 0 && !containsString(r.Type, "string") {
+		return fmt.Sprintf("The property must be of type %s", strings.Join(r.Type, " or "))
+	}
+
+	if len(r.Enum) > 0 {
+		allowed := make([]string, 0, len(r.Enum))
+		for _, v := range r.Enum {
+			if s, ok := v.(string); ok {
+				allowed = append(allowed, s)
+			}
+		}
+		if !containsString(allowed, value) {
+			return fmt.Sprintf("The property must be one of %s", strings.Join(allowed, ", "))
+		}
+	}
+
+	if r.MaxLength != nil && len(value) > *r.MaxLength {
+		return fmt.Sprintf("The property must be at most %d characters long", *r.MaxLength)
+	}
+
+	if r.Pattern != "" {
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return fmt.Sprintf("The pattern %q of the property is invalid: %s", r.Pattern, err)
+		}
+		if !re.MatchString(value) {
+			return fmt.Sprintf("The property must match %s", r.Pattern)
+		}
+	}
+
+	return ""
+}
+
+// property returns the schema of the property with the given name, or false
+// if the property is not allowed.
+func (r Schema) property(name string) (SchemaProperty, bool) {
+	if p, ok := r.Properties[name]; ok {
+		return p, true
+	}
+	if r.AdditionalProperties != nil {
+		return *r.AdditionalProperties, true
+	}
+	return SchemaProperty{}, false
+}
+
+// ValidateProperties checks properties, such as CreateOpts.Properties,
+// against the schema. An ErrInvalidInput is returned for the first property,
+// by name, which is not allowed or whose value is invalid.
+func (r Schema) ValidateProperties(properties map[string]string) error {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := r.validateProperty(name, properties[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateUpdateOpts checks the UpdateImageProperty operations of opts, such
+// as those returned by DiffProperties, against the schema. Other operations
+// are not checked.
+func (r Schema) ValidateUpdateOpts(opts UpdateOpts) error {
+	for _, patch := range opts {
+		var p UpdateImageProperty
+		switch patch := patch.(type) {
+		case UpdateImageProperty:
+			p = patch
+		case propertyPatch:
+			p = patch.UpdateImageProperty
+		default:
+			continue
+		}
+
+		name := unescapePointer(p.Name)
+		if p.Op == RemoveOp {
+			if sp, ok := r.property(name); ok && sp.ReadOnly {
+				err := eclcloud.ErrInvalidInput{}
+				err.Argument = "images.UpdateImageProperty.Name"
+				err.Value = name
+				err.Info = "The property is read-only"
+				return err
+			}
+			continue
+		}
+
+		if err := r.validateProperty(name, p.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r Schema) validateProperty(name, value string) error {
+	var info string
+	if p, ok := r.property(name); !ok {
+		info = "The property is not allowed by the image schema"
+	} else {
+		info = p.validate(value)
+	}
+	if info == "" {
+		return nil
+	}
+
+	err := eclcloud.ErrInvalidInput{}
+	err.Argument = fmt.Sprintf("images.Properties[%s]", name)
+	err.Value = value
+	err.Info = info
+	return err
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// unescapePointer reverses escapePointer.
+func unescapePointer(name string) string {
+	return strings.NewReplacer("~1", "/", "~0", "~").Replace(name)
+}
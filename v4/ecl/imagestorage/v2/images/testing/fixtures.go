@@ -445,3 +445,57 @@ func HandleImageUpdatePropertiesSuccessfully(t *testing.T) {
 		}`)
 	})
 }
+
+// ImageSchemaGetResult is a trimmed down image schema.
+const ImageSchemaGetResult = `
+{
+    "name": "image",
+    "additionalProperties": {
+        "type": "string"
+    },
+    "properties": {
+        "id": {
+            "type": "string",
+            "readOnly": true,
+            "description": "An identifier for the image",
+            "pattern": "^([0-9a-fA-F]){8}-([0-9a-fA-F]){4}-([0-9a-fA-F]){4}-([0-9a-fA-F]){4}-([0-9a-fA-F]){12}$"
+        },
+        "name": {
+            "type": ["null", "string"],
+            "description": "Descriptive name for the image",
+            "maxLength": 255
+        },
+        "min_disk": {
+            "type": "integer",
+            "description": "Amount of disk space (in GB) required to boot image."
+        },
+        "os_hash_algo": {
+            "type": ["null", "string"],
+            "readOnly": true,
+            "description": "Algorithm to calculate the os_hash_value",
+            "maxLength": 64
+        },
+        "os_distro": {
+            "type": "string",
+            "description": "Common name of operating system distribution"
+        },
+        "os_type": {
+            "type": "string",
+            "description": "The operating system installed on the image.",
+            "enum": ["linux", "windows"]
+        }
+    }
+}
+`
+
+// HandleImageSchemaGetSuccessfully setup
+func HandleImageSchemaGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/schemas/image", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ImageSchemaGetResult)
+	})
+}
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -456,3 +457,123 @@ func TestUpdateImageProperties(t *testing.T) {
 
 	th.AssertDeepEquals(t, &expectedImage, actualImage)
 }
+
+func TestGetImageSchema(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleImageSchemaGetSuccessfully(t)
+
+	schema, err := images.GetSchema(fakeclient.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "image", schema.Name)
+	th.AssertDeepEquals(t, &images.SchemaProperty{Type: []string{"string"}}, schema.AdditionalProperties)
+	th.AssertDeepEquals(t, []string{"null", "string"}, schema.Properties["name"].Type)
+	th.AssertEquals(t, 255, *schema.Properties["name"].MaxLength)
+	th.AssertEquals(t, true, schema.Properties["id"].ReadOnly)
+	th.AssertDeepEquals(t, []interface{}{"linux", "windows"}, schema.Properties["os_type"].Enum)
+}
+
+func TestValidateImageProperties(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleImageSchemaGetSuccessfully(t)
+
+	schema, err := images.GetSchema(fakeclient.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+
+	err = schema.ValidateProperties(map[string]string{
+		"os_type":     "linux",
+		"os_distro":   "ubuntu",
+		"hw_disk_bus": "scsi",
+	})
+	th.AssertNoErr(t, err)
+
+	invalid := []map[string]string{
+		{"os_type": "plan9"},
+		{"os_hash_algo": "md5"},
+		{"min_disk": "10"},
+		{"name": strings.Repeat("a", 256)},
+	}
+	for _, properties := range invalid {
+		if err := schema.ValidateProperties(properties); err == nil {
+			t.Errorf("Expected an error for %v", properties)
+		}
+	}
+
+	err = schema.ValidateUpdateOpts(images.UpdateOpts{
+		images.UpdateImageProperty{Op: images.RemoveOp, Name: "os_hash_algo"},
+	})
+	if err == nil {
+		t.Errorf("Expected an error for the removal of a read-only property")
+	}
+
+	schema.AdditionalProperties = nil
+	if err := schema.ValidateProperties(map[string]string{"hw_disk_bus": "scsi"}); err == nil {
+		t.Errorf("Expected an error for an additional property")
+	}
+}
+
+func TestDiffProperties(t *testing.T) {
+	current := map[string]interface{}{
+		"hw_disk_bus":   "scsi",
+		"hw_scsi_model": "virtio-scsi",
+		"os_distro":     "ubuntu",
+		"os_hash_algo":  "sha512",
+		"os_hidden":     false,
+	}
+	desired := map[string]string{
+		"hw_disk_bus":   "virtio",
+		"os_distro":     "ubuntu",
+		"os_type":       "linux",
+		"custom/path~1": "",
+	}
+
+	opts := images.DiffProperties(current, desired)
+	actual, err := opts.ToImageUpdateMap()
+	th.AssertNoErr(t, err)
+
+	expected := []interface{}{
+		map[string]interface{}{"op": images.AddOp, "path": "/custom~1path~01", "value": ""},
+		map[string]interface{}{"op": images.ReplaceOp, "path": "/hw_disk_bus", "value": "virtio"},
+		map[string]interface{}{"op": images.RemoveOp, "path": "/hw_scsi_model"},
+		map[string]interface{}{"op": images.AddOp, "path": "/os_type", "value": "linux"},
+	}
+	th.AssertDeepEquals(t, expected, actual)
+
+	th.AssertEquals(t, 0, len(images.DiffProperties(current, map[string]string{
+		"hw_disk_bus":   "scsi",
+		"hw_scsi_model": "virtio-scsi",
+		"os_distro":     "ubuntu",
+	})))
+}
+
+func TestKnownProperties(t *testing.T) {
+	enabled := true
+	known := images.KnownProperties{
+		OSType:           images.OSTypeLinux,
+		OSDistro:         "ubuntu",
+		HWDiskBus:        images.DiskBusSCSI,
+		HWSCSIModel:      "virtio-scsi",
+		HWVifModel:       images.VifModelVirtio,
+		HWQemuGuestAgent: &enabled,
+	}
+
+	properties := known.ToPropertiesMap()
+	th.AssertDeepEquals(t, map[string]string{
+		"os_type":             "linux",
+		"os_distro":           "ubuntu",
+		"hw_disk_bus":         "scsi",
+		"hw_scsi_model":       "virtio-scsi",
+		"hw_vif_model":        "virtio",
+		"hw_qemu_guest_agent": "yes",
+	}, properties)
+
+	image := images.Image{Properties: map[string]interface{}{}}
+	for k, v := range properties {
+		image.Properties[k] = v
+	}
+	th.AssertDeepEquals(t, known, image.KnownProperties())
+}
@@ -16,6 +16,14 @@ const (
 	// uploaded to Glance
 	ImageStatusSaving ImageStatus = "saving"
 
+	// ImageStatusUploading denotes that image data is being staged for an
+	// import.
+	ImageStatusUploading ImageStatus = "uploading"
+
+	// ImageStatusImporting denotes that image data is being imported with
+	// the image import workflow.
+	ImageStatusImporting ImageStatus = "importing"
+
 	// ImageStatusActive denotes an image that is fully available in Glance.
 	ImageStatusActive ImageStatus = "active"
 
@@ -62,3 +62,7 @@ func nextPageURL(serviceURL, requestedNext string) (string, error) {
 
 	return nextURL.String(), nil
 }
+
+func schemaURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL("schemas", "image")
+}
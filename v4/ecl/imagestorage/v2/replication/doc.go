@@ -0,0 +1,44 @@
+/*
+Package replication replicates an image to other tenants and regions in a
+single call.
+
+Targets in the region of the source image are shared with image
+memberships, which are accepted on behalf of the target tenant when a client
+of that tenant is given. Targets in other regions receive a copy of the
+image, with the same properties and tags, whose data is streamed from the
+source region and verified against the checksums of the source image.
+
+Example to Replicate an Image
+
+	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	opts := replication.ReplicateOpts{
+		SourceRegion: "jp1",
+		Targets: []replication.Target{
+			{
+				TenantID: "2d5d2d7d4a8c4a3c9f4c6a3d4f3e2b1a",
+				Region:   "jp1",
+				Client:   otherTenantImageClient,
+			},
+			{
+				TenantID: "9ee80f2a926c49f88f166af47df4e9f5",
+				Region:   "jp2",
+				Client:   jp2ImageClient,
+			},
+		},
+	}
+
+	results, err := replication.Replicate(imageClient, imageID, opts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, result := range results {
+		if result.Status == replication.StatusFailed {
+			fmt.Printf("%s/%s: %s\n", result.Target.Region, result.Target.TenantID, result.Err)
+			continue
+		}
+		fmt.Printf("%s/%s: %s %s\n", result.Target.Region, result.Target.TenantID, result.Status, result.ImageID)
+	}
+*/
+package replication
@@ -0,0 +1,20 @@
+package replication
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrCleanupFailed is the error when copying an image failed with Err and
+// the partial copy could not be deleted either.
+type ErrCleanupFailed struct {
+	eclcloud.BaseError
+	ImageID    string
+	Err        error
+	CleanupErr error
+}
+
+func (e ErrCleanupFailed) Error() string {
+	return fmt.Sprintf("%s; deleting the partial copy %s failed: %s", e.Err, e.ImageID, e.CleanupErr)
+}
@@ -0,0 +1,263 @@
+package replication
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/imagedata"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/images"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/members"
+)
+
+// Target is a tenant and region an image is replicated to.
+type Target struct {
+	// TenantID is the ID of the tenant the image is replicated to.
+	TenantID string
+
+	// Region is the region the image is replicated to.
+	Region string
+
+	// Client is an Image service client of the target tenant in the target
+	// region. It is required when the image is copied. When the image is
+	// shared, it is optional and used to accept the membership on behalf of
+	// the target tenant.
+	Client *eclcloud.ServiceClient
+}
+
+// ReplicateOpts contains the options for Replicate.
+type ReplicateOpts struct {
+	// SourceRegion is the region of the Image service client of the source
+	// image. Targets in this region are shared, all others are copied.
+	SourceRegion string
+
+	// Targets are the tenants and regions to replicate the image to.
+	Targets []Target
+}
+
+func (opts ReplicateOpts) validate() error {
+	if opts.SourceRegion == "" {
+		return eclcloud.ErrMissingInput{Argument: "replication.ReplicateOpts.SourceRegion"}
+	}
+	for i, t := range opts.Targets {
+		if t.TenantID == "" {
+			return eclcloud.ErrMissingInput{Argument: fmt.Sprintf("replication.ReplicateOpts.Targets[%d].TenantID", i)}
+		}
+		if t.Region == "" {
+			return eclcloud.ErrMissingInput{Argument: fmt.Sprintf("replication.ReplicateOpts.Targets[%d].Region", i)}
+		}
+		if t.Region != opts.SourceRegion && t.Client == nil {
+			return eclcloud.ErrMissingInput{Argument: fmt.Sprintf("replication.ReplicateOpts.Targets[%d].Client", i)}
+		}
+	}
+	return nil
+}
+
+// TargetResult is the outcome of the replication of an image to a Target.
+type TargetResult struct {
+	Target Target
+
+	// Method is the way the image was replicated to the target.
+	Method Method
+
+	// Status is the outcome of the replication.
+	Status Status
+
+	// ImageID is the ID of the image available to the target tenant: the
+	// source image if it was shared, or the new image if it was copied.
+	ImageID string
+
+	// Err is the error the replication failed with, if Status is
+	// StatusFailed.
+	Err error
+}
+
+// Replicate replicates the image with the given ID, read with client, to
+// each of the targets in turn.
+//
+// Targets in the source region are shared: the visibility of the source
+// image is changed to shared if it is private, the target tenant is added as
+// a member of the source image and, if the target has a Client, the membership
+// is accepted. Targets in other regions are copied: an image with the same
+// properties and tags is created with the target Client, and the image data
+// is streamed into it from the source without being stored locally. The MD5
+// checksum of the copied data and, if both images have one, the
+// os_hash_value are verified, and the new image is deleted if the copy
+// fails. If deleting it fails too, the TargetResult holds an
+// ErrCleanupFailed.
+//
+// A failure to replicate to one target does not stop the replication to the
+// others. It is reported in the TargetResult of that target. An error is only
+// returned if the options are invalid or the source image cannot be
+// retrieved.
+func Replicate(client *eclcloud.ServiceClient, imageID string, opts ReplicateOpts) ([]TargetResult, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	source, err := images.Get(client, imageID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]TargetResult, 0, len(opts.Targets))
+	for _, target := range opts.Targets {
+		var result TargetResult
+		if target.Region == opts.SourceRegion {
+			result = share(client, source, target)
+		} else {
+			result = copyImage(client, source, target)
+		}
+		if result.Err != nil {
+			result.Status = StatusFailed
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// share adds the target tenant as a member of the source image, unless it
+// already is, and accepts the membership if the target has a client.
+func share(client *eclcloud.ServiceClient, source *images.Image, target Target) TargetResult {
+	result := TargetResult{Target: target, Method: MethodShare, ImageID: source.ID}
+
+	if target.TenantID == source.Owner || source.Visibility == images.ImageVisibilityPublic {
+		result.Status = StatusSkipped
+		return result
+	}
+
+	// Members can only be added to shared images.
+	if source.Visibility != images.ImageVisibilityShared {
+		updateOpts := images.UpdateOpts{
+			images.UpdateVisibility{Visibility: images.ImageVisibilityShared},
+		}
+		updated, err := images.Update(client, source.ID, updateOpts).Extract()
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		source.Visibility = updated.Visibility
+	}
+
+	member, err := members.Get(client, source.ID, target.TenantID).Extract()
+	if _, ok := err.(eclcloud.ErrDefault404); ok {
+		member, err = members.Create(client, source.ID, target.TenantID).Extract()
+	}
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	if member.Status == string(images.ImageMemberStatusAccepted) {
+		result.Status = StatusAccepted
+		return result
+	}
+	if target.Client == nil {
+		result.Status = StatusShared
+		return result
+	}
+
+	updateOpts := members.UpdateOpts{Status: string(images.ImageMemberStatusAccepted)}
+	if _, err := members.Update(target.Client, source.ID, target.TenantID, updateOpts).Extract(); err != nil {
+		result.Err = err
+		return result
+	}
+
+	result.Status = StatusAccepted
+	return result
+}
+
+// copyImage creates a copy of the source image with the target client and
+// streams the image data into it.
+func copyImage(client *eclcloud.ServiceClient, source *images.Image, target Target) TargetResult {
+	result := TargetResult{Target: target, Method: MethodCopy}
+
+	visibility := images.ImageVisibilityPrivate
+	createOpts := images.CreateOpts{
+		Name:            source.Name,
+		Visibility:      &visibility,
+		Tags:            source.Tags,
+		ContainerFormat: source.ContainerFormat,
+		DiskFormat:      source.DiskFormat,
+		MinDisk:         source.MinDiskGigabytes,
+		MinRAM:          source.MinRAMMegabytes,
+		Properties:      copyableProperties(source),
+	}
+	image, err := images.Create(target.Client, createOpts).Extract()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.ImageID = image.ID
+
+	if err := streamData(client, source, target.Client, image.ID); err != nil {
+		// Do not leave a partial copy behind.
+		if cleanupErr := images.Delete(target.Client, image.ID).ExtractErr(); cleanupErr != nil {
+			err = ErrCleanupFailed{ImageID: image.ID, Err: err, CleanupErr: cleanupErr}
+		}
+		result.Err = err
+		return result
+	}
+
+	result.Status = StatusCopied
+	return result
+}
+
+// copyableProperties returns the properties of the source image which are
+// set on a copy. Only string properties which are not among
+// images.ReadOnlyProperties are copied.
+func copyableProperties(source *images.Image) map[string]string {
+	properties := make(map[string]string)
+	for k, v := range source.Properties {
+		s, ok := v.(string)
+		if !ok || images.ReadOnlyProperties[k] {
+			continue
+		}
+		properties[k] = s
+	}
+	return properties
+}
+
+// streamData uploads the data of the source image to the target image while
+// it is downloaded, and verifies the checksums of both.
+func streamData(sourceClient *eclcloud.ServiceClient, source *images.Image, targetClient *eclcloud.ServiceClient, targetID string) error {
+	data, err := imagedata.Download(sourceClient, source.ID).Extract()
+	if err != nil {
+		return err
+	}
+	if c, ok := data.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	hash := md5.New()
+	err = imagedata.Upload(targetClient, targetID, io.TeeReader(data, hash)).ExtractErr()
+	if err != nil {
+		return err
+	}
+
+	actual := hex.EncodeToString(hash.Sum(nil))
+	if source.Checksum != "" && actual != source.Checksum {
+		return imagedata.ErrChecksumMismatch{ImageID: source.ID, Algorithm: "md5", Expected: source.Checksum, Actual: actual}
+	}
+
+	image, err := images.Get(targetClient, targetID).Extract()
+	if err != nil {
+		return err
+	}
+	if image.Checksum != actual {
+		return imagedata.ErrChecksumMismatch{ImageID: targetID, Algorithm: "md5", Expected: actual, Actual: image.Checksum}
+	}
+
+	algo, _ := source.Properties["os_hash_algo"].(string)
+	expected, _ := source.Properties["os_hash_value"].(string)
+	targetAlgo, _ := image.Properties["os_hash_algo"].(string)
+	value, _ := image.Properties["os_hash_value"].(string)
+	if algo != "" && algo == targetAlgo && expected != "" && value != "" && value != expected {
+		return imagedata.ErrChecksumMismatch{ImageID: targetID, Algorithm: algo, Expected: expected, Actual: value}
+	}
+
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package testing contains replication unit tests
+package testing
@@ -0,0 +1,259 @@
+package testing
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+const (
+	// SourceImageID is the ID of the image which is replicated.
+	SourceImageID = "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	// CopyImageID is the ID of the image created in the target region.
+	CopyImageID = "8a1d6c2c-0e71-4e39-9e4a-4d8c1e8e2b61"
+
+	// OwnerID is the ID of the tenant which owns the source image.
+	OwnerID = "cba624273b8344e59dd1fd18685183b0"
+
+	// MemberID is the ID of the tenant the source image is shared with.
+	MemberID = "2d5d2d7d4a8c4a3c9f4c6a3d4f3e2b1a"
+
+	// CopyTenantID is the ID of the tenant the source image is copied to.
+	CopyTenantID = "9ee80f2a926c49f88f166af47df4e9f5"
+)
+
+// ImageData is the data of the source image.
+var ImageData = []byte("replicated image data")
+
+// ImageChecksum is the MD5 checksum of ImageData.
+var ImageChecksum = checksum(ImageData)
+
+func checksum(b []byte) string {
+	sum := md5.Sum(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// SourceImageResult is the response to a Get request for the source image.
+var SourceImageResult = fmt.Sprintf(`
+{
+    "id": "%s",
+    "name": "golden-image",
+    "status": "active",
+    "visibility": "private",
+    "tags": ["golden", "ubuntu"],
+    "container_format": "bare",
+    "disk_format": "qcow2",
+    "min_disk": 10,
+    "min_ram": 1024,
+    "owner": "%s",
+    "checksum": "%s",
+    "size": 21,
+    "os_hash_algo": "sha512",
+    "os_hash_value": "0e5751c026e543b2e8ab2eb06099daa1d1e5df47778f7787faab45cdf12fe3a8",
+    "os_hidden": false,
+    "hw_disk_bus": "scsi",
+    "schema": "/v2/schemas/image"
+}
+`, SourceImageID, OwnerID, ImageChecksum)
+
+// CopyImageCreateRequest is the request to create the copy of the source
+// image.
+const CopyImageCreateRequest = `
+{
+    "name": "golden-image",
+    "visibility": "private",
+    "tags": ["golden", "ubuntu"],
+    "container_format": "bare",
+    "disk_format": "qcow2",
+    "min_disk": 10,
+    "min_ram": 1024,
+    "hw_disk_bus": "scsi"
+}
+`
+
+// copyImageResult returns the response to requests for the copy of the
+// source image with the given status and checksum.
+func copyImageResult(status, checksum string) string {
+	return fmt.Sprintf(`
+{
+    "id": "%s",
+    "name": "golden-image",
+    "status": "%s",
+    "visibility": "private",
+    "tags": ["golden", "ubuntu"],
+    "container_format": "bare",
+    "disk_format": "qcow2",
+    "min_disk": 10,
+    "min_ram": 1024,
+    "owner": "%s",
+    "checksum": "%s",
+    "hw_disk_bus": "scsi",
+    "schema": "/v2/schemas/image"
+}
+`, CopyImageID, status, CopyTenantID, checksum)
+}
+
+// memberResult returns the response to member requests for MemberID with the
+// given status.
+func memberResult(status string) string {
+	return fmt.Sprintf(`
+{
+    "created_at": "2019-03-12T08:01:52Z",
+    "image_id": "%s",
+    "member_id": "%s",
+    "schema": "/v2/schemas/member",
+    "status": "%s",
+    "updated_at": "2019-03-12T08:01:52Z"
+}
+`, SourceImageID, MemberID, status)
+}
+
+// SharedSourceImageResult is the response to the request which changes the
+// visibility of the source image to shared.
+var SharedSourceImageResult = strings.Replace(SourceImageResult, `"private"`, `"shared"`, 1)
+
+// ShareSourceImageRequest is the request which changes the visibility of the
+// source image to shared.
+const ShareSourceImageRequest = `
+[
+    {
+        "op": "replace",
+        "path": "/visibility",
+        "value": "shared"
+    }
+]
+`
+
+// HandleSourceImageGetSuccessfully configures the test server to respond to a
+// Get request for the source image, to a download of its data and to the
+// request which changes its visibility to shared. It returns a function
+// reporting how many times the visibility was changed.
+func HandleSourceImageGetSuccessfully(t *testing.T) func() int {
+	updates := 0
+
+	th.Mux.HandleFunc("/images/"+SourceImageID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, SourceImageResult)
+		case "PATCH":
+			th.TestHeader(t, r, "Content-Type", "application/openstack-images-v2.1-json-patch")
+			th.TestJSONRequest(t, r, ShareSourceImageRequest)
+			updates++
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, SharedSourceImageResult)
+		default:
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/images/"+SourceImageID+"/file", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		w.Write(ImageData)
+	})
+
+	return func() int { return updates }
+}
+
+// HandleMemberShareSuccessfully configures the test server to respond to the
+// requests which add MemberID as a member of the source image and accept the
+// membership.
+func HandleMemberShareSuccessfully(t *testing.T) {
+	created := false
+	th.Mux.HandleFunc("/images/"+SourceImageID+"/members/"+MemberID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusNotFound)
+		case "PUT":
+			if !created {
+				t.Errorf("The membership was accepted before it was created")
+			}
+			th.TestJSONRequest(t, r, `{"status": "accepted"}`)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, memberResult("accepted"))
+		default:
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/images/"+SourceImageID+"/members", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, fmt.Sprintf(`{"member": "%s"}`, MemberID))
+		created = true
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, memberResult("pending"))
+	})
+}
+
+// HandleImageCopy configures the test server to respond to the requests which
+// create the copy of the source image and upload its data. The copy reports
+// the given checksum. If failDelete is set, the request to delete the copy
+// fails. It returns a function reporting whether the copy was deleted.
+func HandleImageCopy(t *testing.T, checksum string, failDelete bool) func() bool {
+	deleted := false
+
+	th.Mux.HandleFunc("/images", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, CopyImageCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, copyImageResult("queued", ""))
+	})
+
+	th.Mux.HandleFunc("/images/"+CopyImageID+"/file", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "Content-Type", "application/octet-stream")
+
+		b, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		th.AssertByteArrayEquals(t, ImageData, b)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/images/"+CopyImageID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, copyImageResult("active", checksum))
+		case "DELETE":
+			if failDelete {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+	})
+
+	return func() bool { return deleted }
+}
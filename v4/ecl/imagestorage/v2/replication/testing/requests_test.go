@@ -0,0 +1,121 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/imagedata"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/replication"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestReplicate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	updates := HandleSourceImageGetSuccessfully(t)
+	HandleMemberShareSuccessfully(t)
+	deleted := HandleImageCopy(t, ImageChecksum, false)
+
+	client := fakeclient.ServiceClient()
+	opts := replication.ReplicateOpts{
+		SourceRegion: "jp1",
+		Targets: []replication.Target{
+			{TenantID: MemberID, Region: "jp1", Client: client},
+			{TenantID: OwnerID, Region: "jp1"},
+			{TenantID: CopyTenantID, Region: "jp2", Client: client},
+		},
+	}
+
+	results, err := replication.Replicate(client, SourceImageID, opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(results))
+
+	for _, result := range results {
+		th.AssertNoErr(t, result.Err)
+	}
+
+	// The private source image is made shared before the member is added.
+	th.AssertEquals(t, 1, updates())
+
+	th.AssertEquals(t, replication.MethodShare, results[0].Method)
+	th.AssertEquals(t, replication.StatusAccepted, results[0].Status)
+	th.AssertEquals(t, SourceImageID, results[0].ImageID)
+
+	th.AssertEquals(t, replication.MethodShare, results[1].Method)
+	th.AssertEquals(t, replication.StatusSkipped, results[1].Status)
+
+	th.AssertEquals(t, replication.MethodCopy, results[2].Method)
+	th.AssertEquals(t, replication.StatusCopied, results[2].Status)
+	th.AssertEquals(t, CopyImageID, results[2].ImageID)
+	th.AssertEquals(t, false, deleted())
+}
+
+func TestReplicateChecksumMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSourceImageGetSuccessfully(t)
+	deleted := HandleImageCopy(t, checksum([]byte("corrupted image data")), false)
+
+	client := fakeclient.ServiceClient()
+	opts := replication.ReplicateOpts{
+		SourceRegion: "jp1",
+		Targets: []replication.Target{
+			{TenantID: CopyTenantID, Region: "jp2", Client: client},
+		},
+	}
+
+	results, err := replication.Replicate(client, SourceImageID, opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(results))
+	th.AssertEquals(t, replication.StatusFailed, results[0].Status)
+	if _, ok := results[0].Err.(imagedata.ErrChecksumMismatch); !ok {
+		t.Fatalf("Expected ErrChecksumMismatch, got %v", results[0].Err)
+	}
+	th.AssertEquals(t, true, deleted())
+}
+
+func TestReplicateCleanupFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSourceImageGetSuccessfully(t)
+	deleted := HandleImageCopy(t, checksum([]byte("corrupted image data")), true)
+
+	client := fakeclient.ServiceClient()
+	opts := replication.ReplicateOpts{
+		SourceRegion: "jp1",
+		Targets: []replication.Target{
+			{TenantID: CopyTenantID, Region: "jp2", Client: client},
+		},
+	}
+
+	results, err := replication.Replicate(client, SourceImageID, opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, replication.StatusFailed, results[0].Status)
+	cleanup, ok := results[0].Err.(replication.ErrCleanupFailed)
+	if !ok {
+		t.Fatalf("Expected ErrCleanupFailed, got %v", results[0].Err)
+	}
+	th.AssertEquals(t, CopyImageID, cleanup.ImageID)
+	if _, ok := cleanup.Err.(imagedata.ErrChecksumMismatch); !ok {
+		t.Errorf("Expected ErrChecksumMismatch, got %v", cleanup.Err)
+	}
+	if _, ok := cleanup.CleanupErr.(eclcloud.ErrDefault500); !ok {
+		t.Errorf("Expected ErrDefault500, got %v", cleanup.CleanupErr)
+	}
+	th.AssertEquals(t, false, deleted())
+}
+
+func TestReplicateRequiresTargetClient(t *testing.T) {
+	opts := replication.ReplicateOpts{
+		SourceRegion: "jp1",
+		Targets: []replication.Target{
+			{TenantID: CopyTenantID, Region: "jp2"},
+		},
+	}
+
+	_, err := replication.Replicate(fakeclient.ServiceClient(), SourceImageID, opts)
+	if _, ok := err.(eclcloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %v", err)
+	}
+}
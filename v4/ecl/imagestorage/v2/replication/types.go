@@ -0,0 +1,39 @@
+package replication
+
+// Method is the way an image is replicated to a target.
+type Method string
+
+const (
+	// MethodShare makes the source image available to the target tenant by
+	// adding the tenant as an image member.
+	MethodShare Method = "share"
+
+	// MethodCopy creates a new image in the target tenant and region and
+	// copies the image data into it.
+	MethodCopy Method = "copy"
+)
+
+// Status is the outcome of the replication of an image to a target.
+type Status string
+
+const (
+	// StatusShared means that the target tenant was added as a member of the
+	// source image, but has not accepted the membership.
+	StatusShared Status = "shared"
+
+	// StatusAccepted means that the target tenant is an accepted member of
+	// the source image.
+	StatusAccepted Status = "accepted"
+
+	// StatusCopied means that the image was copied to the target and its
+	// checksums were verified.
+	StatusCopied Status = "copied"
+
+	// StatusSkipped means that nothing had to be done, because the target
+	// tenant owns the source image or the source image is public.
+	StatusSkipped Status = "skipped"
+
+	// StatusFailed means that the replication failed. The Err field of the
+	// TargetResult holds the cause.
+	StatusFailed Status = "failed"
+)
@@ -0,0 +1,60 @@
+/*
+Package tasks enables management and retrieval of tasks from the Enterprise
+Cloud Image service.
+
+A task runs a long running operation, such as importing image data from a
+URL, on the server side. Its status and result are retrieved with Get.
+
+Example to List Tasks
+
+	listOpts := tasks.ListOpts{
+		Status: tasks.TaskStatusProcessing,
+	}
+
+	allPages, err := tasks.List(imageClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTasks, err := tasks.ExtractTasks(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, task := range allTasks {
+		fmt.Printf("%+v\n", task)
+	}
+
+Example to Create an Import Task and Wait for its Result
+
+	createOpts := tasks.CreateOpts{
+		Type: tasks.TaskTypeImport,
+		Input: tasks.ImportTaskInput{
+			ImportFrom:       "https://example.com/images/centos.qcow2",
+			ImportFromFormat: "qcow2",
+			ImageProperties: map[string]interface{}{
+				"name":             "centos",
+				"container_format": "bare",
+				"disk_format":      "qcow2",
+			},
+		},
+	}
+
+	task, err := tasks.Create(imageClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	task, err = tasks.WaitForResult(imageClient, task.ID, 3600)
+	if err != nil {
+		panic(err)
+	}
+
+	result, err := task.ExtractImportResult()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result.ImageID)
+*/
+package tasks
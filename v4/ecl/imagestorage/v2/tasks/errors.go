@@ -0,0 +1,17 @@
+package tasks
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrTaskFailed is the error when a task ends with TaskStatusFailure.
+type ErrTaskFailed struct {
+	eclcloud.BaseError
+	Task Task
+}
+
+func (e ErrTaskFailed) Error() string {
+	return fmt.Sprintf("Task %s failed: %s", e.Task.ID, e.Task.Message)
+}
@@ -0,0 +1,146 @@
+package tasks
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToTaskListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the Enterprise Cloud Image API.
+type ListOpts struct {
+	// Integer value for the limit of values to return.
+	Limit int `q:"limit"`
+
+	// ID of the last-seen item from the previous response.
+	Marker string `q:"marker"`
+
+	// SortDir allows to select sort direction.
+	// It can be "asc" or "desc" (default).
+	SortDir string `q:"sort_dir"`
+
+	// SortKey allows to sort by one of the following Task attributes:
+	//  - created_at
+	//  - expires_at
+	//  - status
+	//  - type
+	//  - updated_at
+	// Default is created_at.
+	SortKey string `q:"sort_key"`
+
+	// Type filters on the type of the task.
+	Type TaskType `q:"type"`
+
+	// Status filters on the status of the task.
+	Status TaskStatus `q:"status"`
+}
+
+// ToTaskListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTaskListQuery() (string, error) {
+	q, err := eclcloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of the
+// tasks.
+func List(c *eclcloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToTaskListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		taskPage := TaskPage{
+			serviceURL:     c.ServiceURL(),
+			LinkedPageBase: pagination.LinkedPageBase{PageResult: r},
+		}
+
+		return taskPage
+	})
+}
+
+// Get retrieves a specific Image service task based on its ID.
+func Get(c *eclcloud.ServiceClient, taskID string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, taskID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToTaskCreateMap() (map[string]interface{}, error)
+}
+
+// InputBuilder builds the input of a task.
+type InputBuilder interface {
+	ToTaskInputMap() (map[string]interface{}, error)
+}
+
+// ImportTaskInput is the input of an import task.
+type ImportTaskInput struct {
+	// ImportFrom is the URL the image data is imported from.
+	ImportFrom string `json:"import_from" required:"true"`
+
+	// ImportFromFormat is the disk format of the image data.
+	ImportFromFormat string `json:"import_from_format,omitempty"`
+
+	// ImageProperties are the properties of the image which is created.
+	ImageProperties map[string]interface{} `json:"image_properties,omitempty"`
+}
+
+// ToTaskInputMap assembles the input of an import task based on the contents
+// of an ImportTaskInput.
+func (opts ImportTaskInput) ToTaskInputMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "")
+}
+
+// CreateOpts specifies parameters of a new Image service task.
+type CreateOpts struct {
+	// Type is the type of the task.
+	Type TaskType `json:"type" required:"true"`
+
+	// Input is the input of the task, e.g. an ImportTaskInput.
+	Input InputBuilder `json:"-"`
+}
+
+// ToTaskCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToTaskCreateMap() (map[string]interface{}, error) {
+	b, err := eclcloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Input == nil {
+		err := eclcloud.ErrMissingInput{}
+		err.Argument = "tasks.CreateOpts.Input"
+		return nil, err
+	}
+	input, err := opts.Input.ToTaskInputMap()
+	if err != nil {
+		return nil, err
+	}
+	b["input"] = input
+
+	return b, nil
+}
+
+// Create requests the creation of a new Image service task on the server.
+func Create(client *eclcloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTaskCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
@@ -0,0 +1,139 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+type commonResult struct {
+	eclcloud.Result
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as a Task.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a Create operation. Call its Extract
+// method to interpret it as a Task.
+type CreateResult struct {
+	commonResult
+}
+
+// Task represents a single task of the Enterprise Cloud Image service.
+type Task struct {
+	// ID is a unique identifier of the task.
+	ID string `json:"id"`
+
+	// Type represents the type of the task.
+	Type TaskType `json:"type"`
+
+	// Status represents current status of the task.
+	Status TaskStatus `json:"status"`
+
+	// Owner is a unique identifier of the task owner.
+	Owner string `json:"owner"`
+
+	// ExpiresAt is the datetime when the task will become expired.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// CreatedAt is the datetime when the task was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the datetime when the task was updated.
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Input represents different parameters for the task.
+	Input map[string]interface{} `json:"input"`
+
+	// Result represents task result details.
+	Result map[string]interface{} `json:"result"`
+
+	// Message represents human-readable message that is usually populated
+	// on task failure.
+	Message string `json:"message"`
+
+	// Self contains URI for the task.
+	Self string `json:"self"`
+
+	// Schema is the path to the JSON-schema that represent the task.
+	Schema string `json:"schema"`
+}
+
+// Extract interprets a commonResult as a Task.
+func (r commonResult) Extract() (*Task, error) {
+	var s *Task
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ImportResult is the result of a successful import task.
+type ImportResult struct {
+	// ImageID is the ID of the image the data was imported into.
+	ImageID string `json:"image_id"`
+}
+
+// ExtractImportResult interprets the result of an import task as an
+// ImportResult. An error is returned if the task is not a successful import
+// task.
+func (t Task) ExtractImportResult() (*ImportResult, error) {
+	if t.Type != TaskTypeImport {
+		return nil, fmt.Errorf("task %s is an %q task, not an %q task", t.ID, t.Type, TaskTypeImport)
+	}
+	if t.Status != TaskStatusSuccess {
+		return nil, fmt.Errorf("task %s has status %q, not %q", t.ID, t.Status, TaskStatusSuccess)
+	}
+
+	b, err := json.Marshal(t.Result)
+	if err != nil {
+		return nil, err
+	}
+	var s ImportResult
+	err = json.Unmarshal(b, &s)
+	return &s, err
+}
+
+// TaskPage represents the results of a List request.
+type TaskPage struct {
+	serviceURL string
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a TaskPage contains no Tasks results.
+func (r TaskPage) IsEmpty() (bool, error) {
+	tasks, err := ExtractTasks(r)
+	return len(tasks) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to
+// the next page of results.
+func (r TaskPage) NextPageURL() (string, error) {
+	var s struct {
+		Next string `json:"next"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	if s.Next == "" {
+		return "", nil
+	}
+
+	return nextPageURL(r.serviceURL, s.Next)
+}
+
+// ExtractTasks interprets the results of a single page from a List() call,
+// producing a slice of Task entities.
+func ExtractTasks(r pagination.Page) ([]Task, error) {
+	var s struct {
+		Tasks []Task `json:"tasks"`
+	}
+	err := (r.(TaskPage)).ExtractInto(&s)
+	return s.Tasks, err
+}
@@ -0,0 +1,2 @@
+// Package testing contains tasks unit tests
+package testing
@@ -0,0 +1,202 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/tasks"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+// TaskID is the ID of the task used in the tests.
+const TaskID = "1252f636-1246-4319-bfba-c47cde0efbe0"
+
+// ImageID is the ID of the image created by the task.
+const ImageID = "bf0b9e9c-1c4b-4e2c-9d9a-7a6b1c5f0e2d"
+
+// TasksListResult is a sample response to a List request. It contains a link
+// to a second page.
+const TasksListResult = `
+{
+    "schema": "/v2/schemas/tasks",
+    "tasks": [
+        {
+            "id": "1252f636-1246-4319-bfba-c47cde0efbe0",
+            "owner": "424e7cf0243c468ca61732ba45973b3e",
+            "schema": "/v2/schemas/task",
+            "self": "/v2/tasks/1252f636-1246-4319-bfba-c47cde0efbe0",
+            "status": "pending",
+            "type": "import",
+            "created_at": "2018-07-25T08:59:13Z",
+            "updated_at": "2018-07-25T08:59:14Z",
+            "expires_at": "2018-07-27T08:59:14Z"
+        }
+    ],
+    "next": "/tasks?marker=1252f636-1246-4319-bfba-c47cde0efbe0"
+}
+`
+
+// TasksListSecondPageResult is a sample response to a List request for the
+// second page.
+const TasksListSecondPageResult = `
+{
+    "schema": "/v2/schemas/tasks",
+    "tasks": [
+        {
+            "id": "349a51f4-d51d-47b6-82da-4fa516f0ca32",
+            "owner": "fb57277ef2f84a0e85b9018ec2dedbf7",
+            "schema": "/v2/schemas/task",
+            "self": "/v2/tasks/349a51f4-d51d-47b6-82da-4fa516f0ca32",
+            "status": "failure",
+            "type": "import",
+            "created_at": "2018-07-25T08:59:15Z",
+            "updated_at": "2018-07-25T08:59:16Z",
+            "expires_at": "2018-07-27T08:59:16Z"
+        }
+    ]
+}
+`
+
+// TaskCreateRequest is a sample request to create a task.
+const TaskCreateRequest = `
+{
+    "type": "import",
+    "input": {
+        "import_from": "https://example.com/images/centos.qcow2",
+        "import_from_format": "qcow2",
+        "image_properties": {
+            "container_format": "bare",
+            "disk_format": "qcow2",
+            "name": "centos"
+        }
+    }
+}
+`
+
+// TaskCreateResult is a sample response to a Create request.
+const TaskCreateResult = `
+{
+    "id": "1252f636-1246-4319-bfba-c47cde0efbe0",
+    "type": "import",
+    "status": "pending",
+    "owner": "424e7cf0243c468ca61732ba45973b3e",
+    "input": {
+        "import_from": "https://example.com/images/centos.qcow2",
+        "import_from_format": "qcow2",
+        "image_properties": {
+            "container_format": "bare",
+            "disk_format": "qcow2",
+            "name": "centos"
+        }
+    },
+    "result": null,
+    "message": "",
+    "created_at": "2018-07-25T08:59:13Z",
+    "updated_at": "2018-07-25T08:59:13Z",
+    "self": "/v2/tasks/1252f636-1246-4319-bfba-c47cde0efbe0",
+    "schema": "/v2/schemas/task"
+}
+`
+
+// taskGetResult returns a sample response to a Get request with the given
+// status, result and message.
+func taskGetResult(status tasks.TaskStatus, result, message string) string {
+	return fmt.Sprintf(`
+{
+    "id": "1252f636-1246-4319-bfba-c47cde0efbe0",
+    "type": "import",
+    "status": "%s",
+    "owner": "424e7cf0243c468ca61732ba45973b3e",
+    "input": {
+        "import_from": "https://example.com/images/centos.qcow2",
+        "import_from_format": "qcow2"
+    },
+    "result": %s,
+    "message": "%s",
+    "created_at": "2018-07-25T08:59:13Z",
+    "updated_at": "2018-07-25T09:01:13Z",
+    "expires_at": "2018-07-27T09:01:13Z",
+    "self": "/v2/tasks/1252f636-1246-4319-bfba-c47cde0efbe0",
+    "schema": "/v2/schemas/task"
+}`, status, result, message)
+}
+
+// SuccessfulTask is the expected task of a successful Get request.
+var SuccessfulTask = tasks.Task{
+	ID:     TaskID,
+	Type:   tasks.TaskTypeImport,
+	Status: tasks.TaskStatusSuccess,
+	Owner:  "424e7cf0243c468ca61732ba45973b3e",
+	Input: map[string]interface{}{
+		"import_from":        "https://example.com/images/centos.qcow2",
+		"import_from_format": "qcow2",
+	},
+	Result: map[string]interface{}{
+		"image_id": ImageID,
+	},
+	CreatedAt: time.Date(2018, 7, 25, 8, 59, 13, 0, time.UTC),
+	UpdatedAt: time.Date(2018, 7, 25, 9, 1, 13, 0, time.UTC),
+	ExpiresAt: time.Date(2018, 7, 27, 9, 1, 13, 0, time.UTC),
+	Self:      "/v2/tasks/1252f636-1246-4319-bfba-c47cde0efbe0",
+	Schema:    "/v2/schemas/task",
+}
+
+// HandleTaskListSuccessfully configures the test server to respond to a List
+// request with two pages.
+func HandleTaskListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		r.ParseForm()
+		switch r.Form.Get("marker") {
+		case "":
+			th.AssertEquals(t, "import", r.Form.Get("type"))
+			fmt.Fprint(w, TasksListResult)
+		case TaskID:
+			fmt.Fprint(w, TasksListSecondPageResult)
+		default:
+			t.Fatalf("Unexpected marker: %s", r.Form.Get("marker"))
+		}
+	})
+}
+
+// HandleTaskCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleTaskCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, TaskCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, TaskCreateResult)
+	})
+}
+
+// HandleTaskGet configures the test server to respond to Get requests with
+// the given responses in turn, repeating the last one.
+func HandleTaskGet(t *testing.T, responses ...string) {
+	calls := 0
+	th.Mux.HandleFunc("/tasks/"+TaskID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		response := responses[len(responses)-1]
+		if calls < len(responses) {
+			response = responses[calls]
+		}
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, response)
+	})
+}
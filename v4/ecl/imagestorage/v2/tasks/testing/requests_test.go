@@ -0,0 +1,110 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/imagestorage/v2/tasks"
+	"github.com/nttcom/eclcloud/v4/pagination"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	fakeclient "github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestListTasks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTaskListSuccessfully(t)
+
+	var ids []string
+	pages := 0
+	err := tasks.List(fakeclient.ServiceClient(), tasks.ListOpts{Type: tasks.TaskTypeImport}).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+		actual, err := tasks.ExtractTasks(page)
+		if err != nil {
+			return false, err
+		}
+		for _, task := range actual {
+			ids = append(ids, task.ID)
+		}
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, pages)
+	th.AssertDeepEquals(t, []string{TaskID, "349a51f4-d51d-47b6-82da-4fa516f0ca32"}, ids)
+}
+
+func TestGetTask(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTaskGet(t, taskGetResult(tasks.TaskStatusSuccess, `{"image_id": "`+ImageID+`"}`, ""))
+
+	actual, err := tasks.Get(fakeclient.ServiceClient(), TaskID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SuccessfulTask, *actual)
+
+	result, err := actual.ExtractImportResult()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ImageID, result.ImageID)
+}
+
+func TestCreateTask(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTaskCreateSuccessfully(t)
+
+	createOpts := tasks.CreateOpts{
+		Type: tasks.TaskTypeImport,
+		Input: tasks.ImportTaskInput{
+			ImportFrom:       "https://example.com/images/centos.qcow2",
+			ImportFromFormat: "qcow2",
+			ImageProperties: map[string]interface{}{
+				"container_format": "bare",
+				"disk_format":      "qcow2",
+				"name":             "centos",
+			},
+		},
+	}
+
+	actual, err := tasks.Create(fakeclient.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, TaskID, actual.ID)
+	th.AssertEquals(t, tasks.TaskStatusPending, actual.Status)
+}
+
+func TestCreateTaskRequiresInput(t *testing.T) {
+	res := tasks.Create(fakeclient.ServiceClient(), tasks.CreateOpts{Type: tasks.TaskTypeImport})
+	if _, ok := res.Err.(eclcloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", res.Err)
+	}
+}
+
+func TestWaitForResult(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTaskGet(t,
+		taskGetResult(tasks.TaskStatusProcessing, "null", ""),
+		taskGetResult(tasks.TaskStatusSuccess, `{"image_id": "`+ImageID+`"}`, ""),
+	)
+
+	actual, err := tasks.WaitForResult(fakeclient.ServiceClient(), TaskID, 10)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, tasks.TaskStatusSuccess, actual.Status)
+}
+
+func TestWaitForResultFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTaskGet(t, taskGetResult(tasks.TaskStatusFailure, "null", "Image import failed: 404 Not Found"))
+
+	_, err := tasks.WaitForResult(fakeclient.ServiceClient(), TaskID, 10)
+	failed, ok := err.(tasks.ErrTaskFailed)
+	if !ok {
+		t.Fatalf("expected ErrTaskFailed, got %v", err)
+	}
+	th.AssertEquals(t, "Task 1252f636-1246-4319-bfba-c47cde0efbe0 failed: Image import failed: 404 Not Found", failed.Error())
+
+	_, err = failed.Task.ExtractImportResult()
+	if err == nil {
+		t.Fatal("expected an error extracting the result of a failed task")
+	}
+}
@@ -0,0 +1,27 @@
+package tasks
+
+// TaskStatus represents valid task status.
+type TaskStatus string
+
+const (
+	// TaskStatusPending represents status of the pending task.
+	TaskStatusPending TaskStatus = "pending"
+
+	// TaskStatusProcessing represents status of the processing task.
+	TaskStatusProcessing TaskStatus = "processing"
+
+	// TaskStatusSuccess represents status of the success task.
+	TaskStatusSuccess TaskStatus = "success"
+
+	// TaskStatusFailure represents status of the failure task.
+	TaskStatusFailure TaskStatus = "failure"
+)
+
+// TaskType represents valid task types.
+type TaskType string
+
+const (
+	// TaskTypeImport is the type of a task which imports image data from a
+	// URL into a new image.
+	TaskTypeImport TaskType = "import"
+)
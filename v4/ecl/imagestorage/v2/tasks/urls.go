@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/utils"
+)
+
+func listURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL("tasks")
+}
+
+func createURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL("tasks")
+}
+
+func getURL(c *eclcloud.ServiceClient, taskID string) string {
+	return c.ServiceURL("tasks", taskID)
+}
+
+// builds next page full url based on current url
+func nextPageURL(serviceURL, requestedNext string) (string, error) {
+	base, err := utils.BaseEndpoint(serviceURL)
+	if err != nil {
+		return "", err
+	}
+
+	requestedNextURL, err := url.Parse(requestedNext)
+	if err != nil {
+		return "", err
+	}
+
+	base = eclcloud.NormalizeURL(base)
+	nextPath := base + strings.TrimPrefix(requestedNextURL.Path, "/")
+
+	nextURL, err := url.Parse(nextPath)
+	if err != nil {
+		return "", err
+	}
+
+	nextURL.RawQuery = requestedNextURL.RawQuery
+
+	return nextURL.String(), nil
+}
@@ -0,0 +1,29 @@
+package tasks
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// WaitForResult will continually poll a task until it has succeeded or
+// failed, and returns it. An ErrTaskFailed is returned if the task failed. It
+// will do this for at most the number of seconds specified.
+func WaitForResult(c *eclcloud.ServiceClient, taskID string, secs int) (*Task, error) {
+	var task *Task
+	err := eclcloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, taskID).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		switch current.Status {
+		case TaskStatusSuccess:
+			task = current
+			return true, nil
+		case TaskStatusFailure:
+			return false, ErrTaskFailed{Task: *current}
+		}
+
+		return false, nil
+	})
+	return task, err
+}
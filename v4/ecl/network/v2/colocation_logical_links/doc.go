@@ -0,0 +1,63 @@
+/*
+Package colocation_logical_links contains functionality for working with ECL
+colocation logical link resources. A logical link connects a colocation
+physical port to a tenant network on a given VLAN. Creating a logical link
+causes the API to create a port on the target network, whose ID is reported
+in the PortID attribute; GetPort resolves it to a ports.Port.
+
+Example to List Colocation Logical Links
+
+	listOpts := colocation_logical_links.ListOpts{
+		NetworkID: "8f36b88a-443f-4d97-9751-34d34af9e782",
+	}
+
+	allPages, err := colocation_logical_links.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allLogicalLinks, err := colocation_logical_links.ExtractColocationLogicalLinks(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, logicalLink := range allLogicalLinks {
+		fmt.Printf("%+v\n", logicalLink)
+	}
+
+Example to Create a Colocation Logical Link
+
+	createOpts := colocation_logical_links.CreateOpts{
+		ColocationPhysicalPortID: "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91",
+		Name:                     "rack-a-uplink",
+		NetworkID:                "8f36b88a-443f-4d97-9751-34d34af9e782",
+		VlanID:                   100,
+	}
+
+	logicalLink, err := colocation_logical_links.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = colocation_logical_links.WaitForStatus(networkClient, logicalLink.ID, "ACTIVE", 600)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Resolve the Port of a Colocation Logical Link
+
+	logicalLinkID := "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe"
+	port, err := colocation_logical_links.GetPort(networkClient, logicalLinkID)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Colocation Logical Link
+
+	logicalLinkID := "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe"
+	err := colocation_logical_links.Delete(networkClient, logicalLinkID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package colocation_logical_links
@@ -0,0 +1,18 @@
+package colocation_logical_links
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrNoPortAssigned is the error when a logical link has no port on its
+// network yet, typically because it is still being created.
+type ErrNoPortAssigned struct {
+	eclcloud.BaseError
+	ID string
+}
+
+func (e ErrNoPortAssigned) Error() string {
+	return fmt.Sprintf("Colocation logical link [%s] has no port assigned", e.ID)
+}
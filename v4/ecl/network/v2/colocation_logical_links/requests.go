@@ -0,0 +1,165 @@
+package colocation_logical_links
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToColocationLogicalLinkListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of paginated collections through the API.
+type ListOpts struct {
+	ColocationPhysicalPortID string `q:"colocation_physical_port_id"`
+	Description              string `q:"description"`
+	ID                       string `q:"id"`
+	Name                     string `q:"name"`
+	NetworkID                string `q:"network_id"`
+	PortID                   string `q:"port_id"`
+	Status                   string `q:"status"`
+	TenantID                 string `q:"tenant_id"`
+	VlanID                   int    `q:"vlan_id"`
+}
+
+// ToColocationLogicalLinkListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToColocationLogicalLinkListQuery() (string, error) {
+	q, err := eclcloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// colocation logical links.
+func List(c *eclcloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToColocationLogicalLinkListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return ColocationLogicalLinkPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific colocation logical link based on its unique ID.
+func Get(c *eclcloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToColocationLogicalLinkCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents the attributes used when creating a new colocation
+// logical link.
+type CreateOpts struct {
+	ColocationPhysicalPortID string            `json:"colocation_physical_port_id" required:"true"`
+	Description              string            `json:"description,omitempty"`
+	Name                     string            `json:"name,omitempty"`
+	NetworkID                string            `json:"network_id" required:"true"`
+	Tags                     map[string]string `json:"tags,omitempty"`
+	TenantID                 string            `json:"tenant_id,omitempty"`
+	VlanID                   int               `json:"vlan_id" required:"true"`
+}
+
+// ToColocationLogicalLinkCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToColocationLogicalLinkCreateMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "colocation_logical_link")
+}
+
+// Create accepts a CreateOpts struct and creates a new colocation logical
+// link using the values provided.
+func Create(c *eclcloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToColocationLogicalLinkCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c), b, &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToColocationLogicalLinkUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents the attributes used when updating an existing
+// colocation logical link.
+type UpdateOpts struct {
+	Description *string            `json:"description,omitempty"`
+	Name        *string            `json:"name,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty"`
+}
+
+// ToColocationLogicalLinkUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToColocationLogicalLinkUpdateMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "colocation_logical_link")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing colocation
+// logical link using the values provided.
+func Update(c *eclcloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToColocationLogicalLinkUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(updateURL(c, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// Delete accepts a unique ID and deletes the colocation logical link
+// associated with it.
+func Delete(c *eclcloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return
+}
+
+// IDFromName is a convenience function that returns a colocation logical
+// link's ID, given its name.
+func IDFromName(client *eclcloud.ServiceClient, name string) (string, error) {
+	count := 0
+	id := ""
+
+	listOpts := ListOpts{
+		Name: name,
+	}
+
+	pages, err := List(client, listOpts).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractColocationLogicalLinks(pages)
+	if err != nil {
+		return "", err
+	}
+
+	for _, s := range all {
+		if s.Name == name {
+			count++
+			id = s.ID
+		}
+	}
+
+	switch count {
+	case 0:
+		return "", eclcloud.ErrResourceNotFound{Name: name, ResourceType: "colocation_logical_link"}
+	case 1:
+		return id, nil
+	default:
+		return "", eclcloud.ErrMultipleResourcesFound{Name: name, Count: count, ResourceType: "colocation_logical_link"}
+	}
+}
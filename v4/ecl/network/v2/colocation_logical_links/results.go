@@ -0,0 +1,119 @@
+package colocation_logical_links
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+type commonResult struct {
+	eclcloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a colocation
+// logical link resource.
+func (r commonResult) Extract() (*ColocationLogicalLink, error) {
+	var s ColocationLogicalLink
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "colocation_logical_link")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a ColocationLogicalLink.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a ColocationLogicalLink.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a ColocationLogicalLink.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	eclcloud.ErrResult
+}
+
+// ColocationLogicalLink represents a VLAN connection between a colocation
+// physical port and a tenant network.
+type ColocationLogicalLink struct {
+	// ColocationPhysicalPortID is the physical port carrying the link.
+	ColocationPhysicalPortID string `json:"colocation_physical_port_id"`
+
+	// Description is description
+	Description string `json:"description"`
+
+	// UUID for the logical link.
+	ID string `json:"id"`
+
+	// Human-readable name for the logical link. Might not be unique.
+	Name string `json:"name"`
+
+	// NetworkID is the network the link is attached to.
+	NetworkID string `json:"network_id"`
+
+	// PortID is the port created on NetworkID for the link.
+	PortID string `json:"port_id"`
+
+	// Indicates whether the logical link is currently operational.
+	Status string `json:"status"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags map[string]string `json:"tags"`
+
+	// TenantID is the project owner of the logical link.
+	TenantID string `json:"tenant_id"`
+
+	// VlanID is the VLAN tag used on the physical port.
+	VlanID int `json:"vlan_id"`
+}
+
+// ColocationLogicalLinkPage is the page returned by a pager when traversing
+// over a collection of colocation logical links.
+type ColocationLogicalLinkPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of colocation logical
+// links has reached the end of a page and the pager seeks to traverse over a
+// new one.
+func (r ColocationLogicalLinkPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []eclcloud.Link `json:"colocation_logical_links_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return eclcloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a ColocationLogicalLinkPage struct is empty.
+func (r ColocationLogicalLinkPage) IsEmpty() (bool, error) {
+	is, err := ExtractColocationLogicalLinks(r)
+	return len(is) == 0, err
+}
+
+// ExtractColocationLogicalLinks accepts a Page struct, specifically a
+// ColocationLogicalLinkPage struct, and extracts the elements into a slice of
+// ColocationLogicalLink structs.
+func ExtractColocationLogicalLinks(r pagination.Page) ([]ColocationLogicalLink, error) {
+	var s []ColocationLogicalLink
+	err := ExtractColocationLogicalLinksInto(r, &s)
+	return s, err
+}
+
+func ExtractColocationLogicalLinksInto(r pagination.Page, v interface{}) error {
+	return r.(ColocationLogicalLinkPage).Result.ExtractIntoSlicePtr(v, "colocation_logical_links")
+}
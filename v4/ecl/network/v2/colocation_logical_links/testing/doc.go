@@ -0,0 +1,2 @@
+// colocation_logical_links unit tests
+package testing
@@ -0,0 +1,153 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/colocation_logical_links"
+)
+
+const ListResponse = `
+{
+	"colocation_logical_links": [
+	  {
+		"colocation_physical_port_id": "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91",
+		"description": "",
+		"id": "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe",
+		"name": "rack-a-uplink",
+		"network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+		"port_id": "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730",
+		"status": "ACTIVE",
+		"tags": {},
+		"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1",
+		"vlan_id": 100
+	  },
+	  {
+		"colocation_physical_port_id": "e5b1f7a3-1f57-4f5b-9e36-5f4a10b5a2a8",
+		"description": "",
+		"id": "7e1b1d9f-9c52-4b3f-8a9e-0e6f8c0d3a11",
+		"name": "rack-a-storage",
+		"network_id": "a033d04b-b1fe-4ff4-a7c7-5f4b6da981d2",
+		"port_id": "",
+		"status": "PENDING_CREATE",
+		"tags": {},
+		"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1",
+		"vlan_id": 200
+	  }
+	]
+  }`
+
+const GetResponse = `
+{
+	"colocation_logical_link": {
+	  "colocation_physical_port_id": "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91",
+	  "description": "",
+	  "id": "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe",
+	  "name": "rack-a-uplink",
+	  "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+	  "port_id": "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730",
+	  "status": "ACTIVE",
+	  "tags": {},
+	  "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1",
+	  "vlan_id": 100
+	}
+  }`
+
+const GetPendingResponse = `
+{
+	"colocation_logical_link": {
+	  "colocation_physical_port_id": "e5b1f7a3-1f57-4f5b-9e36-5f4a10b5a2a8",
+	  "description": "",
+	  "id": "7e1b1d9f-9c52-4b3f-8a9e-0e6f8c0d3a11",
+	  "name": "rack-a-storage",
+	  "network_id": "a033d04b-b1fe-4ff4-a7c7-5f4b6da981d2",
+	  "port_id": "",
+	  "status": "PENDING_CREATE",
+	  "tags": {},
+	  "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1",
+	  "vlan_id": 200
+	}
+  }`
+
+const CreateRequest = `
+{
+	"colocation_logical_link": {
+	  "colocation_physical_port_id": "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91",
+	  "name": "rack-a-uplink",
+	  "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+	  "vlan_id": 100
+	}
+  }`
+
+const CreateResponse = GetResponse
+
+const UpdateRequest = `
+{
+	"colocation_logical_link": {
+	  "description": "UPDATED",
+	  "name": "UPDATED"
+	}
+  }`
+
+const UpdateResponse = `
+{
+	"colocation_logical_link": {
+	  "colocation_physical_port_id": "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91",
+	  "description": "UPDATED",
+	  "id": "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe",
+	  "name": "UPDATED",
+	  "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+	  "port_id": "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730",
+	  "status": "PENDING_UPDATE",
+	  "tags": {},
+	  "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1",
+	  "vlan_id": 100
+	}
+  }`
+
+const GetPortResponse = `
+{
+	"port": {
+	  "admin_state_up": true,
+	  "allowed_address_pairs": [],
+	  "description": "",
+	  "device_id": "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe",
+	  "device_owner": "network:colocation_logical_link",
+	  "fixed_ips": [],
+	  "id": "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730",
+	  "mac_address": "fa:16:3e:b0:ca:f1",
+	  "managed_by_service": true,
+	  "name": "",
+	  "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+	  "segmentation_id": 100,
+	  "segmentation_type": "vlan",
+	  "status": "ACTIVE",
+	  "tags": {},
+	  "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	}
+  }`
+
+var ColocationLogicalLink1 = colocation_logical_links.ColocationLogicalLink{
+	ColocationPhysicalPortID: "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91",
+	ID:                       "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe",
+	Name:                     "rack-a-uplink",
+	NetworkID:                "8f36b88a-443f-4d97-9751-34d34af9e782",
+	PortID:                   "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730",
+	Status:                   "ACTIVE",
+	Tags:                     map[string]string{},
+	TenantID:                 "dcb2d589c0c646d0bad45c0cf9f90cf1",
+	VlanID:                   100,
+}
+
+var ColocationLogicalLink2 = colocation_logical_links.ColocationLogicalLink{
+	ColocationPhysicalPortID: "e5b1f7a3-1f57-4f5b-9e36-5f4a10b5a2a8",
+	ID:                       "7e1b1d9f-9c52-4b3f-8a9e-0e6f8c0d3a11",
+	Name:                     "rack-a-storage",
+	NetworkID:                "a033d04b-b1fe-4ff4-a7c7-5f4b6da981d2",
+	Status:                   "PENDING_CREATE",
+	Tags:                     map[string]string{},
+	TenantID:                 "dcb2d589c0c646d0bad45c0cf9f90cf1",
+	VlanID:                   200,
+}
+
+var ExpectedColocationLogicalLinkSlice = []colocation_logical_links.ColocationLogicalLink{
+	ColocationLogicalLink1,
+	ColocationLogicalLink2,
+}
@@ -0,0 +1,200 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/colocation_logical_links"
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/pagination"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+func TestListColocationLogicalLink(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_logical_links", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+
+	client := fake.ServiceClient()
+	count := 0
+
+	colocation_logical_links.List(client, colocation_logical_links.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := colocation_logical_links.ExtractColocationLogicalLinks(page)
+		if err != nil {
+			t.Errorf("Failed to extract colocation logical links: %v", err)
+			return false, nil
+		}
+
+		th.CheckDeepEquals(t, ExpectedColocationLogicalLinkSlice, actual)
+
+		return true, nil
+	})
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGetColocationLogicalLink(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_logical_links/0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetResponse)
+	})
+
+	l, err := colocation_logical_links.Get(fake.ServiceClient(), "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ColocationLogicalLink1, l)
+}
+
+func TestCreateColocationLogicalLink(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_logical_links", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, CreateResponse)
+	})
+
+	options := colocation_logical_links.CreateOpts{
+		ColocationPhysicalPortID: "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91",
+		Name:                     "rack-a-uplink",
+		NetworkID:                "8f36b88a-443f-4d97-9751-34d34af9e782",
+		VlanID:                   100,
+	}
+	l, err := colocation_logical_links.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ColocationLogicalLink1, l)
+}
+
+func TestRequiredCreateOptsColocationLogicalLink(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	res := colocation_logical_links.Create(fake.ServiceClient(), colocation_logical_links.CreateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdateColocationLogicalLink(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_logical_links/0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, UpdateResponse)
+	})
+
+	description := "UPDATED"
+	name := "UPDATED"
+
+	options := colocation_logical_links.UpdateOpts{
+		Description: &description,
+		Name:        &name,
+	}
+	l, err := colocation_logical_links.Update(fake.ServiceClient(), "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe", options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, description, l.Description)
+	th.CheckEquals(t, name, l.Name)
+}
+
+func TestDeleteColocationLogicalLink(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_logical_links/0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := colocation_logical_links.Delete(fake.ServiceClient(), "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestGetPortColocationLogicalLink(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_logical_links/0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetResponse)
+	})
+
+	th.Mux.HandleFunc("/v2.0/ports/ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetPortResponse)
+	})
+
+	p, err := colocation_logical_links.GetPort(fake.ServiceClient(), "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730", p.ID)
+	th.CheckEquals(t, "0d3d4a83-a1c1-42e5-b7a9-1bde7b0a7cbe", p.DeviceID)
+	th.CheckEquals(t, 100, p.SegmentationID)
+}
+
+func TestGetPortColocationLogicalLinkPending(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_logical_links/7e1b1d9f-9c52-4b3f-8a9e-0e6f8c0d3a11", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetPendingResponse)
+	})
+
+	_, err := colocation_logical_links.GetPort(fake.ServiceClient(), "7e1b1d9f-9c52-4b3f-8a9e-0e6f8c0d3a11")
+	if _, ok := err.(colocation_logical_links.ErrNoPortAssigned); !ok {
+		t.Fatalf("Expected ErrNoPortAssigned, got %v", err)
+	}
+}
@@ -0,0 +1,39 @@
+package colocation_logical_links
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+)
+
+// WaitForStatus will continually poll the resource, checking for a particular
+// status. It will do this for the amount of seconds defined.
+func WaitForStatus(c *eclcloud.ServiceClient, id, status string, secs int) error {
+	return eclcloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		return false, nil
+	})
+}
+
+// GetPort retrieves the port which the API created on the target network for
+// the given logical link. It returns ErrNoPortAssigned if the link has no
+// port yet.
+func GetPort(c *eclcloud.ServiceClient, id string) (*ports.Port, error) {
+	link, err := Get(c, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	if link.PortID == "" {
+		return nil, ErrNoPortAssigned{ID: id}
+	}
+
+	return ports.Get(c, link.PortID).Extract()
+}
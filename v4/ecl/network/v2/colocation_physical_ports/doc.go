@@ -0,0 +1,42 @@
+/*
+Package colocation_physical_ports contains functionality for working with ECL
+colocation physical port resources. A colocation physical port is a switch
+port in a colocation space which is cabled to customer equipment. Physical
+ports are provisioned by ECL; tenants may only inspect them and change their
+name, description and tags.
+
+Example to List Colocation Physical Ports
+
+	listOpts := colocation_physical_ports.ListOpts{
+		ColocationSpaceID: "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1",
+	}
+
+	allPages, err := colocation_physical_ports.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allPhysicalPorts, err := colocation_physical_ports.ExtractColocationPhysicalPorts(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, physicalPort := range allPhysicalPorts {
+		fmt.Printf("%+v\n", physicalPort)
+	}
+
+Example to Update a Colocation Physical Port
+
+	physicalPortID := "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91"
+	name := "rack-a-port-1"
+
+	updateOpts := colocation_physical_ports.UpdateOpts{
+		Name: &name,
+	}
+
+	physicalPort, err := colocation_physical_ports.Update(networkClient, physicalPortID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package colocation_physical_ports
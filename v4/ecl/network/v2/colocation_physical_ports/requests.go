@@ -0,0 +1,121 @@
+package colocation_physical_ports
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToColocationPhysicalPortListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of paginated collections through the API.
+type ListOpts struct {
+	ColocationSpaceID string `q:"colocation_space_id"`
+	Description       string `q:"description"`
+	ID                string `q:"id"`
+	Name              string `q:"name"`
+	Plane             string `q:"plane"`
+	Status            string `q:"status"`
+	TenantID          string `q:"tenant_id"`
+}
+
+// ToColocationPhysicalPortListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToColocationPhysicalPortListQuery() (string, error) {
+	q, err := eclcloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// colocation physical ports.
+func List(c *eclcloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToColocationPhysicalPortListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return ColocationPhysicalPortPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific colocation physical port based on its unique ID.
+func Get(c *eclcloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToColocationPhysicalPortUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents the attributes used when updating an existing
+// colocation physical port.
+type UpdateOpts struct {
+	Description *string            `json:"description,omitempty"`
+	Name        *string            `json:"name,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty"`
+}
+
+// ToColocationPhysicalPortUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToColocationPhysicalPortUpdateMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "colocation_physical_port")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing colocation
+// physical port using the values provided.
+func Update(c *eclcloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToColocationPhysicalPortUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(updateURL(c, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// IDFromName is a convenience function that returns a colocation physical
+// port's ID, given its name.
+func IDFromName(client *eclcloud.ServiceClient, name string) (string, error) {
+	count := 0
+	id := ""
+
+	listOpts := ListOpts{
+		Name: name,
+	}
+
+	pages, err := List(client, listOpts).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractColocationPhysicalPorts(pages)
+	if err != nil {
+		return "", err
+	}
+
+	for _, s := range all {
+		if s.Name == name {
+			count++
+			id = s.ID
+		}
+	}
+
+	switch count {
+	case 0:
+		return "", eclcloud.ErrResourceNotFound{Name: name, ResourceType: "colocation_physical_port"}
+	case 1:
+		return id, nil
+	default:
+		return "", eclcloud.ErrMultipleResourcesFound{Name: name, Count: count, ResourceType: "colocation_physical_port"}
+	}
+}
@@ -0,0 +1,101 @@
+package colocation_physical_ports
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+type commonResult struct {
+	eclcloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a colocation
+// physical port resource.
+func (r commonResult) Extract() (*ColocationPhysicalPort, error) {
+	var s ColocationPhysicalPort
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "colocation_physical_port")
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a ColocationPhysicalPort.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a ColocationPhysicalPort.
+type UpdateResult struct {
+	commonResult
+}
+
+// ColocationPhysicalPort represents a switch port in a colocation space.
+type ColocationPhysicalPort struct {
+	// ColocationSpaceID is the colocation space the port is located in.
+	ColocationSpaceID string `json:"colocation_space_id"`
+
+	// Description is description
+	Description string `json:"description"`
+
+	// UUID for the physical port.
+	ID string `json:"id"`
+
+	// Human-readable name for the physical port. Might not be unique.
+	Name string `json:"name"`
+
+	// Plane is the type of traffic carried by the port, either "data" or
+	// "storage".
+	Plane string `json:"plane"`
+
+	// Indicates whether the physical port is currently operational.
+	Status string `json:"status"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags map[string]string `json:"tags"`
+
+	// TenantID is the project owner of the physical port.
+	TenantID string `json:"tenant_id"`
+}
+
+// ColocationPhysicalPortPage is the page returned by a pager when traversing
+// over a collection of colocation physical ports.
+type ColocationPhysicalPortPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of colocation physical
+// ports has reached the end of a page and the pager seeks to traverse over a
+// new one.
+func (r ColocationPhysicalPortPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []eclcloud.Link `json:"colocation_physical_ports_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return eclcloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a ColocationPhysicalPortPage struct is empty.
+func (r ColocationPhysicalPortPage) IsEmpty() (bool, error) {
+	is, err := ExtractColocationPhysicalPorts(r)
+	return len(is) == 0, err
+}
+
+// ExtractColocationPhysicalPorts accepts a Page struct, specifically a
+// ColocationPhysicalPortPage struct, and extracts the elements into a slice of
+// ColocationPhysicalPort structs.
+func ExtractColocationPhysicalPorts(r pagination.Page) ([]ColocationPhysicalPort, error) {
+	var s []ColocationPhysicalPort
+	err := ExtractColocationPhysicalPortsInto(r, &s)
+	return s, err
+}
+
+func ExtractColocationPhysicalPortsInto(r pagination.Page, v interface{}) error {
+	return r.(ColocationPhysicalPortPage).Result.ExtractIntoSlicePtr(v, "colocation_physical_ports")
+}
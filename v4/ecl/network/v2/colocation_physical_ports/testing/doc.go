@@ -0,0 +1,2 @@
+// colocation_physical_ports unit tests
+package testing
@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/colocation_physical_ports"
+)
+
+const ListResponse = `
+{
+	"colocation_physical_ports": [
+	  {
+		"colocation_space_id": "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1",
+		"description": "",
+		"id": "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91",
+		"name": "rack-a-port-1",
+		"plane": "data",
+		"status": "ACTIVE",
+		"tags": {},
+		"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	  },
+	  {
+		"colocation_space_id": "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1",
+		"description": "",
+		"id": "e5b1f7a3-1f57-4f5b-9e36-5f4a10b5a2a8",
+		"name": "rack-a-port-2",
+		"plane": "storage",
+		"status": "ACTIVE",
+		"tags": {},
+		"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	  }
+	]
+  }`
+
+const GetResponse = `
+{
+	"colocation_physical_port": {
+	  "colocation_space_id": "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1",
+	  "description": "",
+	  "id": "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91",
+	  "name": "rack-a-port-1",
+	  "plane": "data",
+	  "status": "ACTIVE",
+	  "tags": {},
+	  "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	}
+  }`
+
+const UpdateRequest = `
+{
+	"colocation_physical_port": {
+	  "description": "UPDATED",
+	  "name": "UPDATED",
+	  "tags": {
+		"rack": "a"
+	  }
+	}
+  }`
+
+const UpdateResponse = `
+{
+	"colocation_physical_port": {
+	  "colocation_space_id": "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1",
+	  "description": "UPDATED",
+	  "id": "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91",
+	  "name": "UPDATED",
+	  "plane": "data",
+	  "status": "ACTIVE",
+	  "tags": {
+		"rack": "a"
+	  },
+	  "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	}
+  }`
+
+var ColocationPhysicalPort1 = colocation_physical_ports.ColocationPhysicalPort{
+	ColocationSpaceID: "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1",
+	ID:                "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91",
+	Name:              "rack-a-port-1",
+	Plane:             "data",
+	Status:            "ACTIVE",
+	Tags:              map[string]string{},
+	TenantID:          "dcb2d589c0c646d0bad45c0cf9f90cf1",
+}
+
+var ColocationPhysicalPort2 = colocation_physical_ports.ColocationPhysicalPort{
+	ColocationSpaceID: "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1",
+	ID:                "e5b1f7a3-1f57-4f5b-9e36-5f4a10b5a2a8",
+	Name:              "rack-a-port-2",
+	Plane:             "storage",
+	Status:            "ACTIVE",
+	Tags:              map[string]string{},
+	TenantID:          "dcb2d589c0c646d0bad45c0cf9f90cf1",
+}
+
+var ExpectedColocationPhysicalPortSlice = []colocation_physical_ports.ColocationPhysicalPort{
+	ColocationPhysicalPort1,
+	ColocationPhysicalPort2,
+}
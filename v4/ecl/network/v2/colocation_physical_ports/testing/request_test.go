@@ -0,0 +1,100 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/colocation_physical_ports"
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/pagination"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+func TestListColocationPhysicalPort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_physical_ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+
+	client := fake.ServiceClient()
+	count := 0
+
+	colocation_physical_ports.List(client, colocation_physical_ports.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := colocation_physical_ports.ExtractColocationPhysicalPorts(page)
+		if err != nil {
+			t.Errorf("Failed to extract colocation physical ports: %v", err)
+			return false, nil
+		}
+
+		th.CheckDeepEquals(t, ExpectedColocationPhysicalPortSlice, actual)
+
+		return true, nil
+	})
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGetColocationPhysicalPort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_physical_ports/b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetResponse)
+	})
+
+	p, err := colocation_physical_ports.Get(fake.ServiceClient(), "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ColocationPhysicalPort1, p)
+}
+
+func TestUpdateColocationPhysicalPort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_physical_ports/b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, UpdateResponse)
+	})
+
+	description := "UPDATED"
+	name := "UPDATED"
+	tags := map[string]string{"rack": "a"}
+
+	options := colocation_physical_ports.UpdateOpts{
+		Description: &description,
+		Name:        &name,
+		Tags:        &tags,
+	}
+	p, err := colocation_physical_ports.Update(fake.ServiceClient(), "b4e3bb4b-8f0a-4e02-9d7b-f6c5b64fbd91", options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, description, p.Description)
+	th.CheckEquals(t, name, p.Name)
+	th.CheckDeepEquals(t, tags, p.Tags)
+}
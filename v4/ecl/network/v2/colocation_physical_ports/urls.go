@@ -0,0 +1,23 @@
+package colocation_physical_ports
+
+import "github.com/nttcom/eclcloud/v4"
+
+func resourceURL(c *eclcloud.ServiceClient, id string) string {
+	return c.ServiceURL("colocation_physical_ports", id)
+}
+
+func rootURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL("colocation_physical_ports")
+}
+
+func getURL(c *eclcloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func listURL(c *eclcloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func updateURL(c *eclcloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
@@ -0,0 +1,31 @@
+/*
+Package colocation_spaces contains functionality for working with ECL
+colocation space resources. A colocation space is the rack space in an ECL
+data center in which customer equipment is housed; it is read-only through
+the API.
+
+Example to List Colocation Spaces
+
+	allPages, err := colocation_spaces.List(networkClient, colocation_spaces.ListOpts{}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allSpaces, err := colocation_spaces.ExtractColocationSpaces(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, space := range allSpaces {
+		fmt.Printf("%+v\n", space)
+	}
+
+Example to Get a Colocation Space
+
+	spaceID := "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1"
+	space, err := colocation_spaces.Get(networkClient, spaceID).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package colocation_spaces
@@ -0,0 +1,73 @@
+package colocation_spaces
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+type commonResult struct {
+	eclcloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a colocation
+// space resource.
+func (r commonResult) Extract() (*ColocationSpace, error) {
+	var s ColocationSpace
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "colocation_space")
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a ColocationSpace.
+type GetResult struct {
+	commonResult
+}
+
+// ColocationSpace represents a rack space in an ECL colocation facility.
+type ColocationSpace struct {
+	Description string `json:"description"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+}
+
+// ColocationSpacePage is the page returned by a pager when traversing over a
+// collection of colocation spaces.
+type ColocationSpacePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of colocation spaces has
+// reached the end of a page and the pager seeks to traverse over a new one.
+func (r ColocationSpacePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []eclcloud.Link `json:"colocation_spaces_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return eclcloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a ColocationSpacePage struct is empty.
+func (r ColocationSpacePage) IsEmpty() (bool, error) {
+	is, err := ExtractColocationSpaces(r)
+	return len(is) == 0, err
+}
+
+// ExtractColocationSpaces accepts a Page struct, specifically a
+// ColocationSpacePage struct, and extracts the elements into a slice of
+// ColocationSpace structs.
+func ExtractColocationSpaces(r pagination.Page) ([]ColocationSpace, error) {
+	var s []ColocationSpace
+	err := ExtractColocationSpacesInto(r, &s)
+	return s, err
+}
+
+func ExtractColocationSpacesInto(r pagination.Page, v interface{}) error {
+	return r.(ColocationSpacePage).Result.ExtractIntoSlicePtr(v, "colocation_spaces")
+}
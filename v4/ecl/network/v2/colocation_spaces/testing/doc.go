@@ -0,0 +1,2 @@
+// colocation_spaces unit tests
+package testing
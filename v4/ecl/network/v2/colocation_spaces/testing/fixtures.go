@@ -0,0 +1,44 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/colocation_spaces"
+)
+
+const ListResponse = `
+{
+	"colocation_spaces": [
+	  {
+		"description": "Tokyo colocation",
+		"id": "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1",
+		"name": "JP1-colo-a"
+	  },
+	  {
+		"description": "Osaka colocation",
+		"id": "c8f2f1a9-9a3c-4fbe-8b8a-4fc8f5a6a0c4",
+		"name": "JP2-colo-a"
+	  }
+	]
+  }`
+
+const GetResponse = `
+{
+	"colocation_space": {
+	  "description": "Tokyo colocation",
+	  "id": "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1",
+	  "name": "JP1-colo-a"
+	}
+  }`
+
+var ColocationSpace1 = colocation_spaces.ColocationSpace{
+	Description: "Tokyo colocation",
+	ID:          "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1",
+	Name:        "JP1-colo-a",
+}
+
+var ColocationSpace2 = colocation_spaces.ColocationSpace{
+	Description: "Osaka colocation",
+	ID:          "c8f2f1a9-9a3c-4fbe-8b8a-4fc8f5a6a0c4",
+	Name:        "JP2-colo-a",
+}
+
+var ExpectedColocationSpaceSlice = []colocation_spaces.ColocationSpace{ColocationSpace1, ColocationSpace2}
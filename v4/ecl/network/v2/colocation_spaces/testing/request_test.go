@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/colocation_spaces"
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/pagination"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+func TestListColocationSpace(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_spaces", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+
+	client := fake.ServiceClient()
+	count := 0
+
+	colocation_spaces.List(client, colocation_spaces.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := colocation_spaces.ExtractColocationSpaces(page)
+		if err != nil {
+			t.Errorf("Failed to extract colocation spaces: %v", err)
+			return false, nil
+		}
+
+		th.CheckDeepEquals(t, ExpectedColocationSpaceSlice, actual)
+
+		return true, nil
+	})
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGetColocationSpace(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/colocation_spaces/5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetResponse)
+	})
+
+	s, err := colocation_spaces.Get(fake.ServiceClient(), "5ae7c1e2-5e10-4e3b-8c2a-0c1d7e9b58d1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ColocationSpace1, s)
+}
@@ -0,0 +1,19 @@
+package colocation_spaces
+
+import "github.com/nttcom/eclcloud/v4"
+
+func resourceURL(c *eclcloud.ServiceClient, id string) string {
+	return c.ServiceURL("colocation_spaces", id)
+}
+
+func rootURL(c *eclcloud.ServiceClient) string {
+	return c.ServiceURL("colocation_spaces")
+}
+
+func getURL(c *eclcloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func listURL(c *eclcloud.ServiceClient) string {
+	return rootURL(c)
+}
@@ -0,0 +1,135 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/gateway_interfaces"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/subnets"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+var validateSubnet = subnets.Subnet{
+	ID:        "ab49eb24-667f-4a4e-9421-b4d915bff416",
+	NetworkID: "8f36b88a-443f-4d97-9751-34d34af9e782",
+	CIDR:      "100.127.254.48/29",
+	IPVersion: 4,
+}
+
+var validateOpts = gateway_interfaces.CreateOpts{
+	GwVipv4:       "100.127.254.49",
+	InternetGwID:  "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+	Netmask:       29,
+	NetworkID:     "8f36b88a-443f-4d97-9751-34d34af9e782",
+	PrimaryIpv4:   "100.127.254.50",
+	SecondaryIpv4: "100.127.254.51",
+	ServiceType:   "internet",
+	VRID:          1,
+}
+
+var validatePorts = []ports.Port{
+	{
+		ID: "5f5b4e14-39c2-4b63-9f60-3e7e5ee2a4b0",
+		FixedIPs: []ports.IP{
+			{SubnetID: "ab49eb24-667f-4a4e-9421-b4d915bff416", IPAddress: "100.127.254.52"},
+		},
+	},
+}
+
+func TestValidate(t *testing.T) {
+	err := gateway_interfaces.Validate(validateOpts, validateSubnet, validatePorts)
+	th.AssertNoErr(t, err)
+}
+
+func TestValidateInvalid(t *testing.T) {
+	tests := []struct {
+		argument string
+		modify   func(*gateway_interfaces.CreateOpts)
+	}{
+		{"NetworkID", func(o *gateway_interfaces.CreateOpts) { o.NetworkID = "a033d04b-b1fe-4ff4-a7c7-5f4b6da981d2" }},
+		{"VRID", func(o *gateway_interfaces.CreateOpts) { o.VRID = 0 }},
+		{"VRID", func(o *gateway_interfaces.CreateOpts) { o.VRID = 256 }},
+		{"Netmask", func(o *gateway_interfaces.CreateOpts) { o.Netmask = 28 }},
+		{"GwVipv4", func(o *gateway_interfaces.CreateOpts) { o.GwVipv4 = "not-an-ip" }},
+		{"GwVipv4", func(o *gateway_interfaces.CreateOpts) { o.GwVipv4 = "100.127.254.48" }},
+		{"PrimaryIpv4", func(o *gateway_interfaces.CreateOpts) { o.PrimaryIpv4 = "100.127.254.56" }},
+		{"SecondaryIpv4", func(o *gateway_interfaces.CreateOpts) { o.SecondaryIpv4 = "100.127.254.55" }},
+		{"SecondaryIpv4", func(o *gateway_interfaces.CreateOpts) { o.SecondaryIpv4 = "100.127.254.50" }},
+		{"PrimaryIpv4", func(o *gateway_interfaces.CreateOpts) { o.PrimaryIpv4 = "100.127.254.52" }},
+	}
+
+	for _, test := range tests {
+		opts := validateOpts
+		test.modify(&opts)
+
+		err := gateway_interfaces.Validate(opts, validateSubnet, validatePorts)
+		invalid, ok := err.(eclcloud.ErrInvalidInput)
+		if !ok {
+			t.Errorf("Expected ErrInvalidInput for %s, got %#v", test.argument, err)
+			continue
+		}
+		th.AssertEquals(t, "gateway_interfaces.CreateOpts."+test.argument, invalid.Argument)
+	}
+}
+
+func TestValidateWithClient(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"network_id": "8f36b88a-443f-4d97-9751-34d34af9e782"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+  "subnets": [
+    {
+      "cidr": "100.127.254.48/29",
+      "id": "ab49eb24-667f-4a4e-9421-b4d915bff416",
+      "ip_version": 4,
+      "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782"
+    }
+  ]
+}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"network_id": "8f36b88a-443f-4d97-9751-34d34af9e782"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+  "ports": [
+    {
+      "fixed_ips": [
+        {
+          "ip_address": "100.127.254.50",
+          "subnet_id": "ab49eb24-667f-4a4e-9421-b4d915bff416"
+        }
+      ],
+      "id": "5f5b4e14-39c2-4b63-9f60-3e7e5ee2a4b0",
+      "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782"
+    }
+  ]
+}`)
+	})
+
+	err := gateway_interfaces.ValidateWithClient(fake.ServiceClient(), validateOpts)
+	invalid, ok := err.(eclcloud.ErrInvalidInput)
+	if !ok {
+		t.Fatalf("Expected ErrInvalidInput, got %#v", err)
+	}
+	th.AssertEquals(t, "gateway_interfaces.CreateOpts.PrimaryIpv4", invalid.Argument)
+}
@@ -0,0 +1,168 @@
+package gateway_interfaces
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/subnets"
+)
+
+// VRID must be within this range.
+const (
+	MinVRID = 1
+	MaxVRID = 255
+)
+
+// Validate checks the VRRP configuration of opts against the subnet the
+// gateway interfaces are created on and the ports already using it.
+//
+// The virtual, primary and secondary addresses must be distinct host
+// addresses of the subnet, Netmask must match the subnet's prefix length and
+// VRID must be within MinVRID and MaxVRID. None of the addresses may be used
+// by an existing port. An ErrInvalidInput naming the offending field is
+// returned otherwise.
+func Validate(opts CreateOpts, subnet subnets.Subnet, existing []ports.Port) error {
+	_, cidr, err := net.ParseCIDR(subnet.CIDR)
+	if err != nil || cidr.IP.To4() == nil {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "subnets.Subnet.CIDR"
+		err.Value = subnet.CIDR
+		err.Info = fmt.Sprintf("Subnet %s does not have an IPv4 CIDR", subnet.ID)
+		return err
+	}
+	prefix, _ := cidr.Mask.Size()
+
+	if subnet.NetworkID != "" && opts.NetworkID != subnet.NetworkID {
+		return invalidInput("NetworkID", opts.NetworkID,
+			fmt.Sprintf("NetworkID %s does not match network %s of subnet %s", opts.NetworkID, subnet.NetworkID, subnet.ID))
+	}
+
+	if opts.VRID < MinVRID || opts.VRID > MaxVRID {
+		return invalidInput("VRID", opts.VRID,
+			fmt.Sprintf("VRID %d is not between %d and %d", opts.VRID, MinVRID, MaxVRID))
+	}
+
+	if opts.Netmask != prefix {
+		return invalidInput("Netmask", opts.Netmask,
+			fmt.Sprintf("Netmask %d does not match prefix length %d of subnet %s", opts.Netmask, prefix, subnet.CIDR))
+	}
+
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"GwVipv4", opts.GwVipv4},
+		{"PrimaryIpv4", opts.PrimaryIpv4},
+		{"SecondaryIpv4", opts.SecondaryIpv4},
+	}
+
+	seen := make(map[string]string)
+	for _, f := range fields {
+		ip := net.ParseIP(f.value).To4()
+		if ip == nil {
+			return invalidInput(f.name, f.value, fmt.Sprintf("%s %q is not a valid IPv4 address", f.name, f.value))
+		}
+		if !isHostAddress(cidr, ip) {
+			return invalidInput(f.name, f.value,
+				fmt.Sprintf("%s %s is not a host address of subnet %s", f.name, f.value, subnet.CIDR))
+		}
+		if other, ok := seen[ip.String()]; ok {
+			return invalidInput(f.name, f.value, fmt.Sprintf("%s %s is the same as %s", f.name, f.value, other))
+		}
+		seen[ip.String()] = f.name
+	}
+
+	for _, p := range existing {
+		for _, fixed := range p.FixedIPs {
+			if subnet.ID != "" && fixed.SubnetID != "" && fixed.SubnetID != subnet.ID {
+				continue
+			}
+			ip := net.ParseIP(fixed.IPAddress)
+			if ip == nil {
+				continue
+			}
+			if name, ok := seen[ip.String()]; ok {
+				return invalidInput(name, fixed.IPAddress,
+					fmt.Sprintf("%s %s is already used by port %s", name, fixed.IPAddress, p.ID))
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateWithClient retrieves the subnet of opts.NetworkID which contains
+// opts.GwVipv4 and the ports on that network, and calls Validate.
+func ValidateWithClient(c *eclcloud.ServiceClient, opts CreateOpts) error {
+	if opts.NetworkID == "" {
+		err := eclcloud.ErrMissingInput{}
+		err.Argument = "gateway_interfaces.CreateOpts.NetworkID"
+		return err
+	}
+
+	pages, err := subnets.List(c, subnets.ListOpts{NetworkID: opts.NetworkID}).AllPages()
+	if err != nil {
+		return err
+	}
+	allSubnets, err := subnets.ExtractSubnets(pages)
+	if err != nil {
+		return err
+	}
+
+	vip := net.ParseIP(opts.GwVipv4)
+	if vip == nil {
+		return invalidInput("GwVipv4", opts.GwVipv4, fmt.Sprintf("GwVipv4 %q is not a valid IPv4 address", opts.GwVipv4))
+	}
+
+	var subnet *subnets.Subnet
+	for i, s := range allSubnets {
+		_, cidr, err := net.ParseCIDR(s.CIDR)
+		if err == nil && cidr.Contains(vip) {
+			subnet = &allSubnets[i]
+			break
+		}
+	}
+	if subnet == nil {
+		return invalidInput("GwVipv4", opts.GwVipv4,
+			fmt.Sprintf("GwVipv4 %s is not within any subnet of network %s", opts.GwVipv4, opts.NetworkID))
+	}
+
+	pages, err = ports.List(c, ports.ListOpts{NetworkID: opts.NetworkID}).AllPages()
+	if err != nil {
+		return err
+	}
+	existing, err := ports.ExtractPorts(pages)
+	if err != nil {
+		return err
+	}
+
+	return Validate(opts, *subnet, existing)
+}
+
+func invalidInput(field string, value interface{}, info string) error {
+	err := eclcloud.ErrInvalidInput{}
+	err.Argument = "gateway_interfaces.CreateOpts." + field
+	err.Value = value
+	err.Info = info
+	return err
+}
+
+// isHostAddress reports whether ip is within cidr, excluding the network and
+// broadcast addresses of subnets larger than /31.
+func isHostAddress(cidr *net.IPNet, ip net.IP) bool {
+	if !cidr.Contains(ip) {
+		return false
+	}
+	ones, bits := cidr.Mask.Size()
+	if bits-ones < 2 {
+		return true
+	}
+	network := cidr.IP.To4()
+	broadcast := make(net.IP, len(network))
+	for i := range network {
+		broadcast[i] = network[i] | ^cidr.Mask[i]
+	}
+	return !ip.Equal(network) && !ip.Equal(broadcast)
+}
@@ -0,0 +1,50 @@
+/*
+Package internet_egress provisions internet egress for a network in a single
+call.
+
+Exposing a network to the internet requires an internet gateway, a VRRP pair
+of gateway interfaces on the network, a block of public IPs and static routes
+towards the network. Provision creates each of these in turn and waits for it
+to become ACTIVE before moving on.
+
+Resources which already exist are found by name and reused, so running
+Provision again with the same options completes a previous, interrupted run
+instead of creating duplicates. An internet gateway, gateway interface or
+public IP which is found but does not match the options, for example because
+it has another QoS option, VRID or addresses, fails its step with an
+ErrResourceConflict. If a step
+fails, the resources created by that run are deleted again in reverse order;
+reused resources are left alone.
+
+Example to Provision Internet Egress
+
+	opts := internet_egress.ProvisionOpts{
+		Name:                  "egress",
+		InternetServiceID:     "5536154d-9a00-4b11-81fb-b185c9111d90",
+		QoSOptionID:           "e497bbc3-1127-4490-a51d-93582c40ab40",
+		NetworkID:             "8f36b88a-443f-4d97-9751-34d34af9e782",
+		Netmask:               29,
+		GwVipv4:               "100.127.254.49",
+		PrimaryIpv4:           "100.127.254.50",
+		SecondaryIpv4:         "100.127.254.51",
+		VRID:                  1,
+		PublicIPSubmaskLength: 29,
+		StaticRoutes: []internet_egress.StaticRouteOpts{
+			{
+				// An empty destination routes the public IP block.
+				Nexthop: "100.127.254.52",
+			},
+		},
+		Progress: func(e internet_egress.Event) {
+			fmt.Printf("%s %s: %s\n", e.Step, e.ID, e.Action)
+		},
+	}
+
+	egress, err := internet_egress.Provision(networkClient, opts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Public IPs: %s/%d\n", egress.PublicIP.Cidr, egress.PublicIP.SubmaskLength)
+*/
+package internet_egress
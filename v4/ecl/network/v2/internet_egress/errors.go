@@ -0,0 +1,59 @@
+package internet_egress
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrUnexpectedStatus is the error when a resource reaches a status from
+// which it will not become ACTIVE.
+type ErrUnexpectedStatus struct {
+	eclcloud.BaseError
+	Step   Step
+	ID     string
+	Status string
+}
+
+func (e ErrUnexpectedStatus) Error() string {
+	return fmt.Sprintf("%s [%s] has unexpected status [%s]", e.Step, e.ID, e.Status)
+}
+
+// ErrResourceConflict is the error when an existing resource found by
+// Provision does not match ProvisionOpts. Field names the first attribute
+// which differs.
+type ErrResourceConflict struct {
+	eclcloud.BaseError
+	Step     Step
+	ID       string
+	Field    string
+	Expected string
+	Actual   string
+}
+
+func (e ErrResourceConflict) Error() string {
+	return fmt.Sprintf("Existing %s [%s] has %s [%s], expected [%s]", e.Step, e.ID, e.Field, e.Actual, e.Expected)
+}
+
+// ErrProvisionFailed is returned by Provision when a step fails. Err is the
+// cause of the failure, and RollbackErrs holds any errors encountered while
+// deleting the resources created before it.
+type ErrProvisionFailed struct {
+	eclcloud.BaseError
+	Step         Step
+	Err          error
+	RollbackErrs []error
+}
+
+func (e ErrProvisionFailed) Error() string {
+	msg := fmt.Sprintf("Failed to provision %s: %s", e.Step, e.Err)
+	if len(e.RollbackErrs) > 0 {
+		errs := make([]string, len(e.RollbackErrs))
+		for i, err := range e.RollbackErrs {
+			errs[i] = err.Error()
+		}
+		msg += fmt.Sprintf("; rollback failed: %s", strings.Join(errs, "; "))
+	}
+	return msg
+}
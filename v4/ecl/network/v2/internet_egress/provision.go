@@ -0,0 +1,538 @@
+package internet_egress
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/gateway_interfaces"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/internet_gateways"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/public_ips"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/static_routes"
+)
+
+const serviceTypeInternet = "internet"
+
+// DefaultTimeout is the number of seconds Provision waits for each resource
+// to become ACTIVE or to be deleted when ProvisionOpts.Timeout is not set.
+const DefaultTimeout = 600
+
+// Step identifies a stage of the provisioning workflow.
+type Step string
+
+const (
+	StepInternetGateway  Step = "internet_gateway"
+	StepGatewayInterface Step = "gateway_interface"
+	StepPublicIP         Step = "public_ip"
+	StepStaticRoute      Step = "static_route"
+)
+
+// Action describes what happened to a resource during a step.
+type Action string
+
+const (
+	// ActionFound means an existing resource was found and reused.
+	ActionFound Action = "found"
+
+	// ActionCreated means the resource was created.
+	ActionCreated Action = "created"
+
+	// ActionActive means the resource reached ACTIVE status.
+	ActionActive Action = "active"
+
+	// ActionDeleted means the resource was deleted during rollback.
+	ActionDeleted Action = "deleted"
+)
+
+// Event reports the progress of Provision.
+type Event struct {
+	Step   Step
+	Action Action
+	ID     string
+}
+
+// StaticRouteOpts specifies a static route towards the network.
+type StaticRouteOpts struct {
+	// Name of the static route. Defaults to ProvisionOpts.Name.
+	Name string
+
+	// Destination CIDR of the route. Defaults to the public IP block.
+	Destination string
+
+	// Nexthop is the address on the network which traffic is routed to.
+	Nexthop string
+}
+
+// ProvisionOpts specifies the internet egress to provision.
+type ProvisionOpts struct {
+	// Name is given to each resource created, and is used to find the
+	// resources of a previous run.
+	Name string
+
+	TenantID string
+
+	// InternetServiceID and QoSOptionID configure the internet gateway.
+	InternetServiceID string
+	QoSOptionID       string
+
+	// NetworkID is the network the gateway interfaces are created on.
+	NetworkID string
+
+	// GwVipv4, PrimaryIpv4, SecondaryIpv4, Netmask and VRID configure the
+	// VRRP pair of gateway interfaces.
+	GwVipv4       string
+	PrimaryIpv4   string
+	SecondaryIpv4 string
+	Netmask       int
+	VRID          int
+
+	// PublicIPSubmaskLength is the size of the public IP block.
+	PublicIPSubmaskLength int
+
+	StaticRoutes []StaticRouteOpts
+
+	// Timeout is the number of seconds to wait for each resource. Defaults
+	// to DefaultTimeout.
+	Timeout int
+
+	// Progress, if set, is called as each resource is found, created,
+	// becomes ACTIVE or is rolled back.
+	Progress func(Event)
+}
+
+func (opts ProvisionOpts) validate() error {
+	required := []struct {
+		argument string
+		missing  bool
+	}{
+		{"Name", opts.Name == ""},
+		{"InternetServiceID", opts.InternetServiceID == ""},
+		{"QoSOptionID", opts.QoSOptionID == ""},
+		{"NetworkID", opts.NetworkID == ""},
+		{"GwVipv4", opts.GwVipv4 == ""},
+		{"PrimaryIpv4", opts.PrimaryIpv4 == ""},
+		{"SecondaryIpv4", opts.SecondaryIpv4 == ""},
+		{"Netmask", opts.Netmask == 0},
+		{"VRID", opts.VRID == 0},
+		{"PublicIPSubmaskLength", opts.PublicIPSubmaskLength == 0},
+	}
+	for _, r := range required {
+		if r.missing {
+			err := eclcloud.ErrMissingInput{}
+			err.Argument = "internet_egress.ProvisionOpts." + r.argument
+			return err
+		}
+	}
+
+	for i, sr := range opts.StaticRoutes {
+		if sr.Nexthop == "" {
+			err := eclcloud.ErrMissingInput{}
+			err.Argument = fmt.Sprintf("internet_egress.ProvisionOpts.StaticRoutes[%d].Nexthop", i)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Egress holds the resources which make up the internet egress of a network.
+type Egress struct {
+	InternetGateway  *internet_gateways.InternetGateway
+	GatewayInterface *gateway_interfaces.GatewayInterface
+	PublicIP         *public_ips.PublicIP
+	StaticRoutes     []static_routes.StaticRoute
+}
+
+// createdResource is a resource created by the current run, which is
+// deleted again on rollback.
+type createdResource struct {
+	step   Step
+	id     string
+	delete func() error
+	get    func() error
+}
+
+type provisioner struct {
+	c       *eclcloud.ServiceClient
+	opts    ProvisionOpts
+	created []createdResource
+}
+
+// Provision creates the internet gateway, gateway interfaces, public IPs and
+// static routes described by opts, waiting for each to become ACTIVE. On
+// failure it deletes the resources it created and returns an
+// ErrProvisionFailed.
+func Provision(c *eclcloud.ServiceClient, opts ProvisionOpts) (*Egress, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = DefaultTimeout
+	}
+
+	p := &provisioner{c: c, opts: opts}
+	egress := &Egress{}
+
+	var err error
+	if egress.InternetGateway, err = p.internetGateway(); err != nil {
+		return nil, p.fail(StepInternetGateway, err)
+	}
+	if egress.GatewayInterface, err = p.gatewayInterface(egress.InternetGateway.ID); err != nil {
+		return nil, p.fail(StepGatewayInterface, err)
+	}
+	if egress.PublicIP, err = p.publicIP(egress.InternetGateway.ID); err != nil {
+		return nil, p.fail(StepPublicIP, err)
+	}
+	for _, sr := range opts.StaticRoutes {
+		if sr.Name == "" {
+			sr.Name = opts.Name
+		}
+		if sr.Destination == "" {
+			sr.Destination = fmt.Sprintf("%s/%d", egress.PublicIP.Cidr, egress.PublicIP.SubmaskLength)
+		}
+		route, err := p.staticRoute(egress.InternetGateway.ID, sr)
+		if err != nil {
+			return nil, p.fail(StepStaticRoute, err)
+		}
+		egress.StaticRoutes = append(egress.StaticRoutes, *route)
+	}
+
+	return egress, nil
+}
+
+func (p *provisioner) internetGateway() (*internet_gateways.InternetGateway, error) {
+	pages, err := internet_gateways.List(p.c, internet_gateways.ListOpts{
+		Name:              p.opts.Name,
+		InternetServiceID: p.opts.InternetServiceID,
+		TenantID:          p.opts.TenantID,
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	all, err := internet_gateways.ExtractInternetGateways(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	var ig *internet_gateways.InternetGateway
+	switch len(all) {
+	case 0:
+		ig, err = internet_gateways.Create(p.c, internet_gateways.CreateOpts{
+			Name:              p.opts.Name,
+			InternetServiceID: p.opts.InternetServiceID,
+			QoSOptionID:       p.opts.QoSOptionID,
+			TenantID:          p.opts.TenantID,
+		}).Extract()
+		if err != nil {
+			return nil, err
+		}
+		p.track(StepInternetGateway, ig.ID,
+			func() error { return internet_gateways.Delete(p.c, ig.ID).ExtractErr() },
+			func() error { return internet_gateways.Get(p.c, ig.ID).Err },
+		)
+	case 1:
+		ig = &all[0]
+		err = conflict(StepInternetGateway, ig.ID, []field{
+			{"qos_option_id", p.opts.QoSOptionID, ig.QoSOptionID},
+		})
+		if err != nil {
+			return nil, err
+		}
+		p.report(StepInternetGateway, ActionFound, ig.ID)
+	default:
+		return nil, eclcloud.ErrMultipleResourcesFound{Name: p.opts.Name, Count: len(all), ResourceType: "internet_gateway"}
+	}
+
+	id := ig.ID
+	err = p.waitForActive(StepInternetGateway, id, ig.Status, func() (string, error) {
+		current, err := internet_gateways.Get(p.c, id).Extract()
+		if err != nil {
+			return "", err
+		}
+		*ig = *current
+		return current.Status, nil
+	})
+	return ig, err
+}
+
+func (p *provisioner) gatewayInterface(internetGwID string) (*gateway_interfaces.GatewayInterface, error) {
+	pages, err := gateway_interfaces.List(p.c, gateway_interfaces.ListOpts{
+		InternetGwID: internetGwID,
+		NetworkID:    p.opts.NetworkID,
+		TenantID:     p.opts.TenantID,
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	all, err := gateway_interfaces.ExtractGatewayInterfaces(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	var gi *gateway_interfaces.GatewayInterface
+	switch len(all) {
+	case 0:
+		gi, err = gateway_interfaces.Create(p.c, gateway_interfaces.CreateOpts{
+			Name:          p.opts.Name,
+			GwVipv4:       p.opts.GwVipv4,
+			InternetGwID:  internetGwID,
+			Netmask:       p.opts.Netmask,
+			NetworkID:     p.opts.NetworkID,
+			PrimaryIpv4:   p.opts.PrimaryIpv4,
+			SecondaryIpv4: p.opts.SecondaryIpv4,
+			ServiceType:   serviceTypeInternet,
+			TenantID:      p.opts.TenantID,
+			VRID:          p.opts.VRID,
+		}).Extract()
+		if err != nil {
+			return nil, err
+		}
+		p.track(StepGatewayInterface, gi.ID,
+			func() error { return gateway_interfaces.Delete(p.c, gi.ID).ExtractErr() },
+			func() error { return gateway_interfaces.Get(p.c, gi.ID).Err },
+		)
+	case 1:
+		gi = &all[0]
+		err = conflict(StepGatewayInterface, gi.ID, []field{
+			{"network_id", p.opts.NetworkID, gi.NetworkID},
+			{"vrid", strconv.Itoa(p.opts.VRID), strconv.Itoa(gi.VRID)},
+			{"gw_vipv4", p.opts.GwVipv4, gi.GwVipv4},
+			{"primary_ipv4", p.opts.PrimaryIpv4, gi.PrimaryIpv4},
+			{"secondary_ipv4", p.opts.SecondaryIpv4, gi.SecondaryIpv4},
+			{"netmask", strconv.Itoa(p.opts.Netmask), strconv.Itoa(gi.Netmask)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		p.report(StepGatewayInterface, ActionFound, gi.ID)
+	default:
+		return nil, eclcloud.ErrMultipleResourcesFound{Name: p.opts.Name, Count: len(all), ResourceType: "gateway_interface"}
+	}
+
+	id := gi.ID
+	err = p.waitForActive(StepGatewayInterface, id, gi.Status, func() (string, error) {
+		current, err := gateway_interfaces.Get(p.c, id).Extract()
+		if err != nil {
+			return "", err
+		}
+		*gi = *current
+		return current.Status, nil
+	})
+	return gi, err
+}
+
+func (p *provisioner) publicIP(internetGwID string) (*public_ips.PublicIP, error) {
+	pages, err := public_ips.List(p.c, public_ips.ListOpts{
+		InternetGwID: internetGwID,
+		Name:         p.opts.Name,
+		TenantID:     p.opts.TenantID,
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	all, err := public_ips.ExtractPublicIPs(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	var ip *public_ips.PublicIP
+	switch len(all) {
+	case 0:
+		ip, err = public_ips.Create(p.c, public_ips.CreateOpts{
+			Name:          p.opts.Name,
+			InternetGwID:  internetGwID,
+			SubmaskLength: p.opts.PublicIPSubmaskLength,
+			TenantID:      p.opts.TenantID,
+		}).Extract()
+		if err != nil {
+			return nil, err
+		}
+		p.track(StepPublicIP, ip.ID,
+			func() error { return public_ips.Delete(p.c, ip.ID).ExtractErr() },
+			func() error { return public_ips.Get(p.c, ip.ID).Err },
+		)
+	case 1:
+		ip = &all[0]
+		err = conflict(StepPublicIP, ip.ID, []field{
+			{"submask_length", strconv.Itoa(p.opts.PublicIPSubmaskLength), strconv.Itoa(ip.SubmaskLength)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		p.report(StepPublicIP, ActionFound, ip.ID)
+	default:
+		return nil, eclcloud.ErrMultipleResourcesFound{Name: p.opts.Name, Count: len(all), ResourceType: "public_ip"}
+	}
+
+	id := ip.ID
+	err = p.waitForActive(StepPublicIP, id, ip.Status, func() (string, error) {
+		current, err := public_ips.Get(p.c, id).Extract()
+		if err != nil {
+			return "", err
+		}
+		*ip = *current
+		return current.Status, nil
+	})
+	return ip, err
+}
+
+func (p *provisioner) staticRoute(internetGwID string, opts StaticRouteOpts) (*static_routes.StaticRoute, error) {
+	pages, err := static_routes.List(p.c, static_routes.ListOpts{
+		Destination:  opts.Destination,
+		InternetGwID: internetGwID,
+		Nexthop:      opts.Nexthop,
+		TenantID:     p.opts.TenantID,
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	all, err := static_routes.ExtractStaticRoutes(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	var sr *static_routes.StaticRoute
+	switch len(all) {
+	case 0:
+		sr, err = static_routes.Create(p.c, static_routes.CreateOpts{
+			Name:         opts.Name,
+			Destination:  opts.Destination,
+			InternetGwID: internetGwID,
+			Nexthop:      opts.Nexthop,
+			ServiceType:  serviceTypeInternet,
+			TenantID:     p.opts.TenantID,
+		}).Extract()
+		if err != nil {
+			return nil, err
+		}
+		p.track(StepStaticRoute, sr.ID,
+			func() error { return static_routes.Delete(p.c, sr.ID).ExtractErr() },
+			func() error { return static_routes.Get(p.c, sr.ID).Err },
+		)
+	case 1:
+		sr = &all[0]
+		p.report(StepStaticRoute, ActionFound, sr.ID)
+	default:
+		return nil, eclcloud.ErrMultipleResourcesFound{Name: opts.Destination, Count: len(all), ResourceType: "static_route"}
+	}
+
+	id := sr.ID
+	err = p.waitForActive(StepStaticRoute, id, sr.Status, func() (string, error) {
+		current, err := static_routes.Get(p.c, id).Extract()
+		if err != nil {
+			return "", err
+		}
+		*sr = *current
+		return current.Status, nil
+	})
+	return sr, err
+}
+
+// field is an attribute of an existing resource which must match
+// ProvisionOpts.
+type field struct {
+	name     string
+	expected string
+	actual   string
+}
+
+// conflict returns an ErrResourceConflict for the first of fields which
+// differs, or nil if all of them match.
+func conflict(step Step, id string, fields []field) error {
+	for _, f := range fields {
+		if f.expected != f.actual {
+			return ErrResourceConflict{Step: step, ID: id, Field: f.name, Expected: f.expected, Actual: f.actual}
+		}
+	}
+	return nil
+}
+
+func (p *provisioner) report(step Step, action Action, id string) {
+	if p.opts.Progress != nil {
+		p.opts.Progress(Event{Step: step, Action: action, ID: id})
+	}
+}
+
+func (p *provisioner) track(step Step, id string, delete, get func() error) {
+	p.created = append(p.created, createdResource{step: step, id: id, delete: delete, get: get})
+	p.report(step, ActionCreated, id)
+}
+
+// waitForActive waits until status, or the status returned by get, is
+// ACTIVE. Resources which are already ACTIVE are not polled.
+func (p *provisioner) waitForActive(step Step, id, status string, get func() (string, error)) error {
+	check := func(status string) (bool, error) {
+		switch status {
+		case "ACTIVE":
+			return true, nil
+		case "ERROR":
+			return false, ErrUnexpectedStatus{Step: step, ID: id, Status: status}
+		}
+		return false, nil
+	}
+
+	done, err := check(status)
+	if err != nil {
+		return err
+	}
+	if !done {
+		err = eclcloud.WaitFor(p.opts.Timeout, func() (bool, error) {
+			status, err := get()
+			if err != nil {
+				return false, err
+			}
+			return check(status)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	p.report(step, ActionActive, id)
+	return nil
+}
+
+// fail rolls back the resources created so far, most recent first, and
+// returns the error describing the failed step.
+func (p *provisioner) fail(step Step, err error) error {
+	failure := ErrProvisionFailed{Step: step, Err: err}
+
+	for i := len(p.created) - 1; i >= 0; i-- {
+		r := p.created[i]
+		if err := p.rollback(r); err != nil {
+			failure.RollbackErrs = append(failure.RollbackErrs, fmt.Errorf("%s [%s]: %s", r.step, r.id, err))
+			continue
+		}
+		p.report(r.step, ActionDeleted, r.id)
+	}
+	p.created = nil
+
+	return failure
+}
+
+func (p *provisioner) rollback(r createdResource) error {
+	if err := r.delete(); err != nil {
+		if _, ok := err.(eclcloud.ErrDefault404); !ok {
+			return err
+		}
+	}
+
+	// Later resources must be gone before the ones they depend on can be
+	// deleted, so wait until the resource no longer exists.
+	gone := func() (bool, error) {
+		err := r.get()
+		if err == nil {
+			return false, nil
+		}
+		if _, ok := err.(eclcloud.ErrDefault404); ok {
+			return true, nil
+		}
+		return false, err
+	}
+
+	done, err := gone()
+	if err != nil || done {
+		return err
+	}
+	return eclcloud.WaitFor(p.opts.Timeout, gone)
+}
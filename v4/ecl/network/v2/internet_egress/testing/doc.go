@@ -0,0 +1,2 @@
+// internet_egress unit tests
+package testing
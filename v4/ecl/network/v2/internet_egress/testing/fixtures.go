@@ -0,0 +1,271 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/gateway_interfaces"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/internet_egress"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/internet_gateways"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/public_ips"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/static_routes"
+)
+
+const (
+	InternetGatewayID  = "e72ef35a-c96f-45f8-aeee-e7547c5b94b3"
+	GatewayInterfaceID = "09771fbb-6496-4ae1-9b53-226b6edcc1be"
+	PublicIPID         = "0718a31b-67be-4349-946b-61a0fc38e4cd"
+	StaticRouteID      = "93aaec0f-1546-4062-88c5-93c397b93c03"
+)
+
+var Opts = internet_egress.ProvisionOpts{
+	Name:                  "egress",
+	TenantID:              "dcb2d589c0c646d0bad45c0cf9f90cf1",
+	InternetServiceID:     "5536154d-9a00-4b11-81fb-b185c9111d90",
+	QoSOptionID:           "e497bbc3-1127-4490-a51d-93582c40ab40",
+	NetworkID:             "8f36b88a-443f-4d97-9751-34d34af9e782",
+	Netmask:               29,
+	GwVipv4:               "100.127.254.49",
+	PrimaryIpv4:           "100.127.254.50",
+	SecondaryIpv4:         "100.127.254.51",
+	VRID:                  1,
+	PublicIPSubmaskLength: 29,
+	StaticRoutes: []internet_egress.StaticRouteOpts{
+		{Nexthop: "100.127.254.52"},
+	},
+}
+
+const InternetGatewayCreateRequest = `
+{
+  "internet_gateway": {
+    "internet_service_id": "5536154d-9a00-4b11-81fb-b185c9111d90",
+    "name": "egress",
+    "qos_option_id": "e497bbc3-1127-4490-a51d-93582c40ab40",
+    "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+  }
+}
+`
+
+const InternetGatewayResponse = `
+{
+  "internet_gateway": {
+    "description": "",
+    "id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+    "internet_service_id": "5536154d-9a00-4b11-81fb-b185c9111d90",
+    "name": "egress",
+    "qos_option_id": "e497bbc3-1127-4490-a51d-93582c40ab40",
+    "status": "ACTIVE",
+    "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+  }
+}
+`
+
+const InternetGatewayListResponse = `
+{
+  "internet_gateways": [
+    {
+      "description": "",
+      "id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+      "internet_service_id": "5536154d-9a00-4b11-81fb-b185c9111d90",
+      "name": "egress",
+      "qos_option_id": "e497bbc3-1127-4490-a51d-93582c40ab40",
+      "status": "ACTIVE",
+      "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+    }
+  ]
+}
+`
+
+const GatewayInterfaceCreateRequest = `
+{
+  "gw_interface": {
+    "description": "",
+    "gw_vipv4": "100.127.254.49",
+    "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+    "name": "egress",
+    "netmask": 29,
+    "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+    "primary_ipv4": "100.127.254.50",
+    "secondary_ipv4": "100.127.254.51",
+    "service_type": "internet",
+    "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1",
+    "vrid": 1
+  }
+}
+`
+
+const GatewayInterfaceResponse = `
+{
+  "gw_interface": {
+    "description": "",
+    "gw_vipv4": "100.127.254.49",
+    "id": "09771fbb-6496-4ae1-9b53-226b6edcc1be",
+    "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+    "name": "egress",
+    "netmask": 29,
+    "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+    "primary_ipv4": "100.127.254.50",
+    "secondary_ipv4": "100.127.254.51",
+    "service_type": "internet",
+    "status": "ACTIVE",
+    "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1",
+    "vrid": 1
+  }
+}
+`
+
+const GatewayInterfaceListResponse = `
+{
+  "gw_interfaces": [
+    {
+      "description": "",
+      "gw_vipv4": "100.127.254.49",
+      "id": "09771fbb-6496-4ae1-9b53-226b6edcc1be",
+      "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+      "name": "egress",
+      "netmask": 29,
+      "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+      "primary_ipv4": "100.127.254.50",
+      "secondary_ipv4": "100.127.254.51",
+      "service_type": "internet",
+      "status": "ACTIVE",
+      "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1",
+      "vrid": 1
+    }
+  ]
+}
+`
+
+const PublicIPCreateRequest = `
+{
+  "public_ip": {
+    "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+    "name": "egress",
+    "submask_length": 29,
+    "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+  }
+}
+`
+
+const PublicIPResponse = `
+{
+  "public_ip": {
+    "cidr": "203.0.113.8",
+    "description": "",
+    "id": "0718a31b-67be-4349-946b-61a0fc38e4cd",
+    "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+    "name": "egress",
+    "status": "ACTIVE",
+    "submask_length": 29,
+    "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+  }
+}
+`
+
+const PublicIPListResponse = `
+{
+  "public_ips": [
+    {
+      "cidr": "203.0.113.8",
+      "description": "",
+      "id": "0718a31b-67be-4349-946b-61a0fc38e4cd",
+      "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+      "name": "egress",
+      "status": "ACTIVE",
+      "submask_length": 29,
+      "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+    }
+  ]
+}
+`
+
+const StaticRouteCreateRequest = `
+{
+  "static_route": {
+    "description": "",
+    "destination": "203.0.113.8/29",
+    "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+    "name": "egress",
+    "nexthop": "100.127.254.52",
+    "service_type": "internet",
+    "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+  }
+}
+`
+
+const StaticRouteResponse = `
+{
+  "static_route": {
+    "description": "",
+    "destination": "203.0.113.8/29",
+    "id": "93aaec0f-1546-4062-88c5-93c397b93c03",
+    "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+    "name": "egress",
+    "nexthop": "100.127.254.52",
+    "service_type": "internet",
+    "status": "ACTIVE",
+    "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+  }
+}
+`
+
+const StaticRouteListResponse = `
+{
+  "static_routes": [
+    {
+      "description": "",
+      "destination": "203.0.113.8/29",
+      "id": "93aaec0f-1546-4062-88c5-93c397b93c03",
+      "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+      "name": "egress",
+      "nexthop": "100.127.254.52",
+      "service_type": "internet",
+      "status": "ACTIVE",
+      "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+    }
+  ]
+}
+`
+
+var ExpectedEgress = internet_egress.Egress{
+	InternetGateway: &internet_gateways.InternetGateway{
+		ID:                InternetGatewayID,
+		InternetServiceID: "5536154d-9a00-4b11-81fb-b185c9111d90",
+		Name:              "egress",
+		QoSOptionID:       "e497bbc3-1127-4490-a51d-93582c40ab40",
+		Status:            "ACTIVE",
+		TenantID:          "dcb2d589c0c646d0bad45c0cf9f90cf1",
+	},
+	GatewayInterface: &gateway_interfaces.GatewayInterface{
+		GwVipv4:       "100.127.254.49",
+		ID:            GatewayInterfaceID,
+		InternetGwID:  InternetGatewayID,
+		Name:          "egress",
+		Netmask:       29,
+		NetworkID:     "8f36b88a-443f-4d97-9751-34d34af9e782",
+		PrimaryIpv4:   "100.127.254.50",
+		SecondaryIpv4: "100.127.254.51",
+		ServiceType:   "internet",
+		Status:        "ACTIVE",
+		TenantID:      "dcb2d589c0c646d0bad45c0cf9f90cf1",
+		VRID:          1,
+	},
+	PublicIP: &public_ips.PublicIP{
+		Cidr:          "203.0.113.8",
+		ID:            PublicIPID,
+		InternetGwID:  InternetGatewayID,
+		Name:          "egress",
+		Status:        "ACTIVE",
+		SubmaskLength: 29,
+		TenantID:      "dcb2d589c0c646d0bad45c0cf9f90cf1",
+	},
+	StaticRoutes: []static_routes.StaticRoute{
+		{
+			Destination:  "203.0.113.8/29",
+			ID:           StaticRouteID,
+			InternetGwID: InternetGatewayID,
+			Name:         "egress",
+			Nexthop:      "100.127.254.52",
+			ServiceType:  "internet",
+			Status:       "ACTIVE",
+			TenantID:     "dcb2d589c0c646d0bad45c0cf9f90cf1",
+		},
+	},
+}
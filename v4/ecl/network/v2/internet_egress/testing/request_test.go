@@ -0,0 +1,239 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/internet_egress"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+type resourceHandler struct {
+	path           string
+	id             string
+	listResponse   string
+	createRequest  string
+	createResponse string
+	failCreate     bool
+}
+
+// handleResources registers handlers for the given resources and returns a
+// pointer to the IDs deleted, in order.
+func handleResources(t *testing.T, resources []resourceHandler) *[]string {
+	deleted := &[]string{}
+
+	for _, res := range resources {
+		res := res
+		th.Mux.HandleFunc("/v2.0/"+res.path, func(w http.ResponseWriter, r *http.Request) {
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+
+			switch r.Method {
+			case "GET":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, res.listResponse)
+			case "POST":
+				if res.createRequest == "" {
+					t.Errorf("Unexpected create request for %s", res.path)
+				} else {
+					th.TestJSONRequest(t, r, res.createRequest)
+				}
+				if res.failCreate {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintf(w, res.createResponse)
+			default:
+				t.Errorf("Unexpected method %s for %s", r.Method, r.URL.Path)
+			}
+		})
+
+		th.Mux.HandleFunc("/v2.0/"+res.path+"/"+res.id, func(w http.ResponseWriter, r *http.Request) {
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			switch r.Method {
+			case "DELETE":
+				*deleted = append(*deleted, res.id)
+				w.WriteHeader(http.StatusNoContent)
+			case "GET":
+				w.WriteHeader(http.StatusNotFound)
+			default:
+				t.Errorf("Unexpected method %s for %s", r.Method, r.URL.Path)
+			}
+		})
+	}
+
+	return deleted
+}
+
+func TestProvision(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	empty := func(key string) string { return fmt.Sprintf(`{"%s": []}`, key) }
+	deleted := handleResources(t, []resourceHandler{
+		{"internet_gateways", InternetGatewayID, empty("internet_gateways"), InternetGatewayCreateRequest, InternetGatewayResponse, false},
+		{"gw_interfaces", GatewayInterfaceID, empty("gw_interfaces"), GatewayInterfaceCreateRequest, GatewayInterfaceResponse, false},
+		{"public_ips", PublicIPID, empty("public_ips"), PublicIPCreateRequest, PublicIPResponse, false},
+		{"static_routes", StaticRouteID, empty("static_routes"), StaticRouteCreateRequest, StaticRouteResponse, false},
+	})
+
+	var events []internet_egress.Event
+	opts := Opts
+	opts.Progress = func(e internet_egress.Event) { events = append(events, e) }
+
+	actual, err := internet_egress.Provision(fake.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEgress, *actual)
+	th.AssertEquals(t, 0, len(*deleted))
+
+	expectedEvents := []internet_egress.Event{
+		{Step: internet_egress.StepInternetGateway, Action: internet_egress.ActionCreated, ID: InternetGatewayID},
+		{Step: internet_egress.StepInternetGateway, Action: internet_egress.ActionActive, ID: InternetGatewayID},
+		{Step: internet_egress.StepGatewayInterface, Action: internet_egress.ActionCreated, ID: GatewayInterfaceID},
+		{Step: internet_egress.StepGatewayInterface, Action: internet_egress.ActionActive, ID: GatewayInterfaceID},
+		{Step: internet_egress.StepPublicIP, Action: internet_egress.ActionCreated, ID: PublicIPID},
+		{Step: internet_egress.StepPublicIP, Action: internet_egress.ActionActive, ID: PublicIPID},
+		{Step: internet_egress.StepStaticRoute, Action: internet_egress.ActionCreated, ID: StaticRouteID},
+		{Step: internet_egress.StepStaticRoute, Action: internet_egress.ActionActive, ID: StaticRouteID},
+	}
+	th.CheckDeepEquals(t, expectedEvents, events)
+}
+
+func TestProvisionExisting(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleResources(t, []resourceHandler{
+		{"internet_gateways", InternetGatewayID, InternetGatewayListResponse, "", "", false},
+		{"gw_interfaces", GatewayInterfaceID, GatewayInterfaceListResponse, "", "", false},
+		{"public_ips", PublicIPID, PublicIPListResponse, "", "", false},
+		{"static_routes", StaticRouteID, StaticRouteListResponse, "", "", false},
+	})
+
+	var found int
+	opts := Opts
+	opts.Progress = func(e internet_egress.Event) {
+		if e.Action == internet_egress.ActionFound {
+			found++
+		}
+	}
+
+	actual, err := internet_egress.Provision(fake.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEgress, *actual)
+	th.AssertEquals(t, 4, found)
+}
+
+func TestProvisionExistingConflict(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	empty := func(key string) string { return fmt.Sprintf(`{"%s": []}`, key) }
+	handleResources(t, []resourceHandler{
+		{"internet_gateways", InternetGatewayID, InternetGatewayListResponse, "", "", false},
+		{"gw_interfaces", GatewayInterfaceID, GatewayInterfaceListResponse, "", "", false},
+		{"public_ips", PublicIPID, empty("public_ips"), "", "", false},
+		{"static_routes", StaticRouteID, empty("static_routes"), "", "", false},
+	})
+
+	opts := Opts
+	opts.VRID = 2
+
+	_, err := internet_egress.Provision(fake.ServiceClient(), opts)
+	failure, ok := err.(internet_egress.ErrProvisionFailed)
+	if !ok {
+		t.Fatalf("Expected ErrProvisionFailed, got %#v", err)
+	}
+	th.AssertEquals(t, internet_egress.StepGatewayInterface, failure.Step)
+
+	expected := internet_egress.ErrResourceConflict{
+		Step:     internet_egress.StepGatewayInterface,
+		ID:       GatewayInterfaceID,
+		Field:    "vrid",
+		Expected: "2",
+		Actual:   "1",
+	}
+	th.CheckDeepEquals(t, expected, failure.Err)
+}
+
+func TestProvisionExistingGatewayConflict(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	empty := func(key string) string { return fmt.Sprintf(`{"%s": []}`, key) }
+	handleResources(t, []resourceHandler{
+		{"internet_gateways", InternetGatewayID, InternetGatewayListResponse, "", "", false},
+		{"gw_interfaces", GatewayInterfaceID, empty("gw_interfaces"), "", "", false},
+		{"public_ips", PublicIPID, empty("public_ips"), "", "", false},
+		{"static_routes", StaticRouteID, empty("static_routes"), "", "", false},
+	})
+
+	opts := Opts
+	opts.QoSOptionID = "a8d1f3cc-0e95-4b2c-9d3e-4c1b0f6e2a71"
+
+	_, err := internet_egress.Provision(fake.ServiceClient(), opts)
+	failure, ok := err.(internet_egress.ErrProvisionFailed)
+	if !ok {
+		t.Fatalf("Expected ErrProvisionFailed, got %#v", err)
+	}
+	th.AssertEquals(t, internet_egress.StepInternetGateway, failure.Step)
+
+	expected := internet_egress.ErrResourceConflict{
+		Step:     internet_egress.StepInternetGateway,
+		ID:       InternetGatewayID,
+		Field:    "qos_option_id",
+		Expected: "a8d1f3cc-0e95-4b2c-9d3e-4c1b0f6e2a71",
+		Actual:   "e497bbc3-1127-4490-a51d-93582c40ab40",
+	}
+	th.CheckDeepEquals(t, expected, failure.Err)
+}
+
+func TestProvisionRollback(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	empty := func(key string) string { return fmt.Sprintf(`{"%s": []}`, key) }
+	deleted := handleResources(t, []resourceHandler{
+		// The internet gateway already exists and must not be rolled back.
+		{"internet_gateways", InternetGatewayID, InternetGatewayListResponse, "", "", false},
+		{"gw_interfaces", GatewayInterfaceID, empty("gw_interfaces"), GatewayInterfaceCreateRequest, GatewayInterfaceResponse, false},
+		{"public_ips", PublicIPID, empty("public_ips"), PublicIPCreateRequest, PublicIPResponse, false},
+		{"static_routes", StaticRouteID, empty("static_routes"), StaticRouteCreateRequest, "", true},
+	})
+
+	_, err := internet_egress.Provision(fake.ServiceClient(), Opts)
+	failure, ok := err.(internet_egress.ErrProvisionFailed)
+	if !ok {
+		t.Fatalf("Expected ErrProvisionFailed, got %#v", err)
+	}
+	th.AssertEquals(t, internet_egress.StepStaticRoute, failure.Step)
+	th.AssertEquals(t, 0, len(failure.RollbackErrs))
+	if _, ok := failure.Err.(eclcloud.ErrDefault500); !ok {
+		t.Errorf("Expected ErrDefault500, got %#v", failure.Err)
+	}
+
+	th.CheckDeepEquals(t, []string{PublicIPID, GatewayInterfaceID}, *deleted)
+}
+
+func TestProvisionRequiredOpts(t *testing.T) {
+	opts := Opts
+	opts.NetworkID = ""
+
+	_, err := internet_egress.Provision(fake.ServiceClient(), opts)
+	if _, ok := err.(eclcloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %#v", err)
+	}
+
+	opts = Opts
+	opts.StaticRoutes = []internet_egress.StaticRouteOpts{{Destination: "0.0.0.0/0"}}
+
+	_, err = internet_egress.Provision(fake.ServiceClient(), opts)
+	if _, ok := err.(eclcloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %#v", err)
+	}
+}
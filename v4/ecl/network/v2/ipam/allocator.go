@@ -0,0 +1,379 @@
+package ipam
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/subnets"
+)
+
+// DefaultHoldTimeout is the HoldTimeout of a new Allocator.
+const DefaultHoldTimeout = 10 * time.Minute
+
+// Allocator hands out free IPv4 addresses from a single subnet. It is safe
+// for concurrent use.
+//
+// Addresses handed out by Next or Reserve are held by the Allocator until
+// they are confirmed with Confirm, released with Release, or HoldTimeout has
+// passed. Allocators do not share held addresses, so concurrent allocations
+// from one subnet must use the same Allocator.
+type Allocator struct {
+	// HoldTimeout is how long an address handed out by Next or Reserve is
+	// held before it is available again. Zero or less holds addresses until
+	// they are confirmed or released.
+	HoldTimeout time.Duration
+
+	mu       sync.Mutex
+	subnetID string
+	cidr     ipRange
+	pools    []ipRange
+	reserved []ipRange
+	used     map[uint32]string
+	held     map[uint32]time.Time
+}
+
+// NewAllocator returns an Allocator for the given subnet. The fixed IPs of
+// the given ports which belong to the subnet are treated as in use, as are the
+// subnet's gateway IP, the next hops of its host routes and any additional
+// reserved ranges.
+func NewAllocator(subnet subnets.Subnet, existing []ports.Port, reserved ...Range) (*Allocator, error) {
+	if subnet.IPVersion != 0 && subnet.IPVersion != 4 {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "subnets.Subnet.IPVersion"
+		err.Value = subnet.IPVersion
+		err.Info = "Only IPv4 subnets are supported"
+		return nil, err
+	}
+
+	_, cidr, err := net.ParseCIDR(subnet.CIDR)
+	if err != nil || cidr.IP.To4() == nil {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "subnets.Subnet.CIDR"
+		err.Value = subnet.CIDR
+		return nil, err
+	}
+
+	a := &Allocator{
+		HoldTimeout: DefaultHoldTimeout,
+		subnetID:    subnet.ID,
+		used:        make(map[uint32]string),
+		held:        make(map[uint32]time.Time),
+	}
+
+	network, _ := parseIPv4(cidr.IP.String())
+	ones, bits := cidr.Mask.Size()
+	broadcast := network | (uint32(1)<<uint(bits-ones) - 1)
+	a.cidr = ipRange{start: network, end: broadcast}
+
+	// Only host addresses of the CIDR can be allocated, which excludes the
+	// network and broadcast addresses unless the subnet is too small to have
+	// them.
+	hosts := a.cidr
+	if broadcast-network > 1 {
+		hosts = ipRange{start: network + 1, end: broadcast - 1}
+	}
+
+	if len(subnet.AllocationPools) == 0 {
+		a.pools = []ipRange{hosts}
+	}
+	for _, p := range subnet.AllocationPools {
+		r, err := parseRange(p.Start, p.End, "allocation pool")
+		if err != nil {
+			return nil, err
+		}
+		if r, ok := r.intersect(hosts); ok {
+			a.pools = append(a.pools, r)
+		}
+	}
+	sort.Slice(a.pools, func(i, j int) bool { return a.pools[i].start < a.pools[j].start })
+
+	if subnet.GatewayIP != "" {
+		if err := a.markUsed(subnet.GatewayIP, "the gateway IP"); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, hr := range subnet.HostRoutes {
+		owner := fmt.Sprintf("host route %s", hr.DestinationCIDR)
+		_, dest, err := net.ParseCIDR(hr.DestinationCIDR)
+		if err != nil || dest.IP.To4() == nil {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "subnets.HostRoute.DestinationCIDR"
+			err.Value = hr.DestinationCIDR
+			return nil, err
+		}
+
+		// Addresses of the subnet which are routed elsewhere cannot be
+		// assigned. Destinations which contain the whole subnet, such as a
+		// default route, do not take addresses from it.
+		destOnes, _ := dest.Mask.Size()
+		if destOnes > ones && cidr.Contains(dest.IP) {
+			r := cidrRange(dest)
+			r.owner = owner
+			a.reserved = append(a.reserved, r)
+		}
+
+		if hr.NextHop == "" {
+			continue
+		}
+		if err := a.markUsed(hr.NextHop, fmt.Sprintf("the next hop of %s", owner)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, p := range existing {
+		for _, ip := range p.FixedIPs {
+			if ip.SubnetID != subnet.ID || ip.IPAddress == "" {
+				continue
+			}
+			if err := a.markUsed(ip.IPAddress, fmt.Sprintf("port %s", p.ID)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, rr := range reserved {
+		owner := rr.Owner
+		if owner == "" {
+			owner = "a reserved range"
+		}
+		r, err := parseRange(rr.Start, rr.End, owner)
+		if err != nil {
+			return nil, err
+		}
+		a.reserved = append(a.reserved, r)
+	}
+
+	return a, nil
+}
+
+// NewAllocatorFromClient retrieves the subnet with the given ID and the ports
+// of its network, and returns an Allocator for the subnet.
+func NewAllocatorFromClient(c *eclcloud.ServiceClient, subnetID string, reserved ...Range) (*Allocator, error) {
+	subnet, err := subnets.Get(c, subnetID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	allPages, err := ports.List(c, ports.ListOpts{NetworkID: subnet.NetworkID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAllocator(*subnet, allPorts, reserved...)
+}
+
+func (a *Allocator) markUsed(s, owner string) error {
+	ip, err := parseIPv4(s)
+	if err != nil {
+		return err
+	}
+	if _, ok := a.used[ip]; !ok {
+		a.used[ip] = owner
+	}
+	return nil
+}
+
+// conflict returns the owner of ip if it is not available, or "" otherwise.
+// Expired holds are dropped. a.mu must be locked by the caller.
+func (a *Allocator) conflict(ip uint32) string {
+	if owner, ok := a.used[ip]; ok {
+		return owner
+	}
+	for _, r := range a.reserved {
+		if r.contains(ip) {
+			return r.owner
+		}
+	}
+	if deadline, ok := a.held[ip]; ok {
+		if deadline.IsZero() || time.Now().Before(deadline) {
+			return "a pending allocation"
+		}
+		delete(a.held, ip)
+	}
+	return ""
+}
+
+// Check reports whether the given address may be assigned. It returns
+// ErrAddressConflict if the address is already in use and
+// ErrAddressOutOfRange if it is outside the subnet's CIDR. Addresses outside
+// the allocation pools but within the CIDR may be assigned explicitly.
+func (a *Allocator) Check(address string) error {
+	ip, err := parseIPv4(address)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.check(address, ip)
+}
+
+func (a *Allocator) check(address string, ip uint32) error {
+	if owner := a.conflict(ip); owner != "" {
+		return ErrAddressConflict{Address: address, Owner: owner}
+	}
+	hasNetworkAndBroadcast := a.cidr.end-a.cidr.start > 1
+	if !a.cidr.contains(ip) || (hasNetworkAndBroadcast && (ip == a.cidr.start || ip == a.cidr.end)) {
+		return ErrAddressOutOfRange{Address: address, SubnetID: a.subnetID}
+	}
+	return nil
+}
+
+// Reserve marks specific addresses as allocated. Either all addresses are
+// reserved or, if any of them is unavailable, none are.
+func (a *Allocator) Reserve(addresses ...string) error {
+	ips := make([]uint32, len(addresses))
+	for i, address := range addresses {
+		ip, err := parseIPv4(address)
+		if err != nil {
+			return err
+		}
+		ips[i] = ip
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	seen := make(map[uint32]struct{}, len(ips))
+	for i, ip := range ips {
+		if err := a.check(addresses[i], ip); err != nil {
+			return err
+		}
+		if _, ok := seen[ip]; ok {
+			return ErrAddressConflict{Address: addresses[i], Owner: "another address in the same request"}
+		}
+		seen[ip] = struct{}{}
+	}
+
+	a.hold(ips)
+	return nil
+}
+
+// Next allocates the next n free addresses of the subnet, in ascending
+// order. Either all n addresses are allocated or, if the subnet does not have
+// enough free addresses, none are and ErrInsufficientAddresses is returned.
+func (a *Allocator) Next(n int) ([]string, error) {
+	if n < 1 {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "n"
+		err.Value = n
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var ips []uint32
+	for _, p := range a.pools {
+		for ip := p.start; len(ips) < n; ip++ {
+			if a.conflict(ip) == "" {
+				ips = append(ips, ip)
+			}
+			if ip == p.end {
+				break
+			}
+		}
+		if len(ips) == n {
+			break
+		}
+	}
+
+	if len(ips) < n {
+		return nil, ErrInsufficientAddresses{SubnetID: a.subnetID, Requested: n, Available: len(ips)}
+	}
+
+	a.hold(ips)
+
+	addresses := make([]string, len(ips))
+	for i, ip := range ips {
+		addresses[i] = formatIPv4(ip)
+	}
+	return addresses, nil
+}
+
+// Free returns the number of addresses which are currently available for
+// allocation.
+func (a *Allocator) Free() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	free := 0
+	for _, p := range a.pools {
+		for ip := p.start; ; ip++ {
+			if a.conflict(ip) == "" {
+				free++
+			}
+			if ip == p.end {
+				break
+			}
+		}
+	}
+	return free
+}
+
+// Release returns addresses previously obtained from Next or Reserve to the
+// pool. Call it for addresses which turn out not to be needed, for example
+// because creating the resource which was to use them failed.
+func (a *Allocator) Release(addresses ...string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, address := range addresses {
+		if ip, err := parseIPv4(address); err == nil {
+			delete(a.held, ip)
+		}
+	}
+}
+
+// Confirm marks addresses previously obtained from Next or Reserve as in use
+// for good, once the resource which uses them has been created. Confirmed
+// addresses no longer expire.
+func (a *Allocator) Confirm(addresses ...string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, address := range addresses {
+		ip, err := parseIPv4(address)
+		if err != nil {
+			continue
+		}
+		delete(a.held, ip)
+		if _, ok := a.used[ip]; !ok {
+			a.used[ip] = "a confirmed allocation"
+		}
+	}
+}
+
+// Settle confirms addresses if err, usually the error of creating the
+// resource which uses them, is nil, and releases them otherwise.
+func (a *Allocator) Settle(err error, addresses ...string) {
+	if err != nil {
+		a.Release(addresses...)
+		return
+	}
+	a.Confirm(addresses...)
+}
+
+// hold records ips as pending until HoldTimeout has passed. a.mu must be
+// locked by the caller.
+func (a *Allocator) hold(ips []uint32) {
+	var deadline time.Time
+	if a.HoldTimeout > 0 {
+		deadline = time.Now().Add(a.HoldTimeout)
+	}
+	for _, ip := range ips {
+		a.held[ip] = deadline
+	}
+}
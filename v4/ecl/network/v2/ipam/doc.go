@@ -0,0 +1,61 @@
+/*
+Package ipam provides client-side IP address management for ECL subnets.
+
+An Allocator reads a subnet's CIDR, allocation pools, gateway IP and host
+routes together with the fixed IPs of existing ports, and hands out
+the next free IPv4 addresses. Additional ranges, such as the ip_addr_pool of
+a virtual storage attached to the subnet, can be excluded as well. This is
+useful for resources whose addresses must be chosen by the caller, such as
+the primary_ipv4, secondary_ipv4 and gw_vipv4 of a gateway interface or the
+fixed IPs of a VNA appliance.
+
+Addresses handed out by Next or Reserve are held by the Allocator, so that
+concurrent callers sharing it never get the same address. Settle confirms
+them once the resource using them has been created, or releases them if that
+failed. Addresses which are neither confirmed nor released are available
+again after the Allocator's HoldTimeout.
+
+Example to Allocate Addresses for a Gateway Interface
+
+	allocator, err := ipam.NewAllocatorFromClient(networkClient, subnetID)
+	if err != nil {
+		panic(err)
+	}
+
+	ips, err := allocator.Next(3)
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := gateway_interfaces.CreateOpts{
+		GwVipv4:       ips[0],
+		PrimaryIpv4:   ips[1],
+		SecondaryIpv4: ips[2],
+		...
+	}
+
+	gatewayInterface, err := gateway_interfaces.Create(networkClient, createOpts).Extract()
+	allocator.Settle(err, ips...)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Exclude Virtual Storage Address Pools
+
+	allPages, err := virtualstorages.List(storageClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allVirtualStorages, err := virtualstorages.ExtractVirtualStorages(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	reserved := ipam.RangesFromVirtualStorages(subnetID, allVirtualStorages)
+	allocator, err := ipam.NewAllocatorFromClient(networkClient, subnetID, reserved...)
+	if err != nil {
+		panic(err)
+	}
+*/
+package ipam
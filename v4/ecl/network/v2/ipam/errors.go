@@ -0,0 +1,44 @@
+package ipam
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrAddressConflict is the error when an address is already in use within
+// a subnet or is otherwise unavailable for allocation.
+type ErrAddressConflict struct {
+	eclcloud.BaseError
+	Address string
+	Owner   string
+}
+
+func (e ErrAddressConflict) Error() string {
+	return fmt.Sprintf("IP address [%s] conflicts with %s", e.Address, e.Owner)
+}
+
+// ErrAddressOutOfRange is the error when an address is not a host address of
+// the subnet's CIDR.
+type ErrAddressOutOfRange struct {
+	eclcloud.BaseError
+	Address  string
+	SubnetID string
+}
+
+func (e ErrAddressOutOfRange) Error() string {
+	return fmt.Sprintf("IP address [%s] is not a host address of subnet [%s]", e.Address, e.SubnetID)
+}
+
+// ErrInsufficientAddresses is the error when a subnet does not have enough
+// free addresses to satisfy a request.
+type ErrInsufficientAddresses struct {
+	eclcloud.BaseError
+	SubnetID  string
+	Requested int
+	Available int
+}
+
+func (e ErrInsufficientAddresses) Error() string {
+	return fmt.Sprintf("Subnet [%s] has %d free addresses, %d requested", e.SubnetID, e.Available, e.Requested)
+}
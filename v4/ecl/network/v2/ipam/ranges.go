@@ -0,0 +1,103 @@
+package ipam
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/storage/v1/virtualstorages"
+)
+
+// Range is an inclusive range of IPv4 addresses which must not be handed out
+// by an Allocator. Owner describes what holds the range and is reported in
+// ErrAddressConflict.
+type Range struct {
+	Start string
+	End   string
+	Owner string
+}
+
+// RangesFromVirtualStorages returns the ip_addr_pool ranges of the virtual
+// storages connected to the given subnet.
+func RangesFromVirtualStorages(subnetID string, vs []virtualstorages.VirtualStorage) []Range {
+	var ranges []Range
+	for _, v := range vs {
+		if v.SubnetID != subnetID || v.IPAddrPool.Start == "" {
+			continue
+		}
+		ranges = append(ranges, Range{
+			Start: v.IPAddrPool.Start,
+			End:   v.IPAddrPool.End,
+			Owner: fmt.Sprintf("virtual storage %s", v.ID),
+		})
+	}
+	return ranges
+}
+
+// ipRange is a parsed, inclusive range of IPv4 addresses.
+type ipRange struct {
+	start uint32
+	end   uint32
+	owner string
+}
+
+func (r ipRange) contains(ip uint32) bool {
+	return ip >= r.start && ip <= r.end
+}
+
+// intersect returns the addresses r has in common with o, and false if there
+// are none.
+func (r ipRange) intersect(o ipRange) (ipRange, bool) {
+	if r.start < o.start {
+		r.start = o.start
+	}
+	if r.end > o.end {
+		r.end = o.end
+	}
+	return r, r.start <= r.end
+}
+
+// cidrRange returns the addresses of an IPv4 network.
+func cidrRange(n *net.IPNet) ipRange {
+	start := binary.BigEndian.Uint32(n.IP.To4())
+	ones, bits := n.Mask.Size()
+	return ipRange{start: start, end: start | (uint32(1)<<uint(bits-ones) - 1)}
+}
+
+func parseRange(start, end, owner string) (ipRange, error) {
+	s, err := parseIPv4(start)
+	if err != nil {
+		return ipRange{}, err
+	}
+	e, err := parseIPv4(end)
+	if err != nil {
+		return ipRange{}, err
+	}
+	if e < s {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "ipam.Range"
+		err.Value = fmt.Sprintf("%s-%s", start, end)
+		err.Info = fmt.Sprintf("Range end %s is before range start %s", end, start)
+		return ipRange{}, err
+	}
+	return ipRange{start: s, end: e, owner: owner}, nil
+}
+
+func parseIPv4(s string) (uint32, error) {
+	ip := net.ParseIP(s).To4()
+	if ip == nil {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "ipam.IPAddress"
+		err.Value = s
+		err.Info = fmt.Sprintf("%s is not a valid IPv4 address", s)
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(ip), nil
+}
+
+func formatIPv4(ip uint32) string {
+	b := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(b, ip)
+	return b.String()
+}
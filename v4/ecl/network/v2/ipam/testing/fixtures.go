@@ -0,0 +1,115 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/subnets"
+	"github.com/nttcom/eclcloud/v4/ecl/storage/v1/virtualstorages"
+)
+
+const GetSubnetResponse = `
+{
+	"subnet": {
+	  "allocation_pools": [
+		{
+		  "end": "192.168.2.20",
+		  "start": "192.168.2.2"
+		}
+	  ],
+	  "cidr": "192.168.2.0/24",
+	  "description": "",
+	  "dns_nameservers": [],
+	  "enable_dhcp": true,
+	  "gateway_ip": "192.168.2.1",
+	  "host_routes": [
+		{
+		  "destination": "10.0.0.0/8",
+		  "nexthop": "192.168.2.3"
+		}
+	  ],
+	  "id": "ab49eb24-667f-4a4e-9421-b4d915bff416",
+	  "ip_version": 4,
+	  "name": "subnet_1",
+	  "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+	  "ntp_servers": [],
+	  "status": "ACTIVE",
+	  "tags": {},
+	  "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	}
+  }`
+
+const ListPortsResponse = `
+{
+	"ports": [
+	  {
+		"admin_state_up": true,
+		"allowed_address_pairs": [],
+		"description": "DHCP Server Port",
+		"device_id": "",
+		"device_owner": "network:dhcp",
+		"fixed_ips": [
+		  {
+			"ip_address": "192.168.2.2",
+			"subnet_id": "ab49eb24-667f-4a4e-9421-b4d915bff416"
+		  }
+		],
+		"id": "8db1ba30-be40-4943-a7be-ed5b98f053b3",
+		"mac_address": "00:00:5e:00:01:00",
+		"name": "dhcp-server-port",
+		"network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+		"status": "ACTIVE",
+		"tags": {},
+		"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	  }
+	]
+  }`
+
+// Subnet is 192.168.2.0/24 with a pool of .2-.20, gateway .1 and a host
+// route via .3.
+var Subnet = subnets.Subnet{
+	AllocationPools: []subnets.AllocationPool{
+		{Start: "192.168.2.2", End: "192.168.2.20"},
+	},
+	CIDR:      "192.168.2.0/24",
+	GatewayIP: "192.168.2.1",
+	HostRoutes: []subnets.HostRoute{
+		{DestinationCIDR: "10.0.0.0/8", NextHop: "192.168.2.3"},
+	},
+	ID:        "ab49eb24-667f-4a4e-9421-b4d915bff416",
+	IPVersion: 4,
+	NetworkID: "8f36b88a-443f-4d97-9751-34d34af9e782",
+}
+
+var Ports = []ports.Port{
+	{
+		ID: "8db1ba30-be40-4943-a7be-ed5b98f053b3",
+		FixedIPs: []ports.IP{
+			{SubnetID: "ab49eb24-667f-4a4e-9421-b4d915bff416", IPAddress: "192.168.2.2"},
+		},
+	},
+	{
+		ID: "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730",
+		FixedIPs: []ports.IP{
+			{SubnetID: "ab49eb24-667f-4a4e-9421-b4d915bff416", IPAddress: "192.168.2.5"},
+			{SubnetID: "f6aa2d33-f3ae-4c4e-82f7-0d4ab4c67678", IPAddress: "192.168.2.4"},
+		},
+	},
+}
+
+var VirtualStorages = []virtualstorages.VirtualStorage{
+	{
+		ID:       "440cf918-3ee0-4143-b289-f63e1d2000e6",
+		SubnetID: "ab49eb24-667f-4a4e-9421-b4d915bff416",
+		IPAddrPool: virtualstorages.IPAddressPool{
+			Start: "192.168.2.6",
+			End:   "192.168.2.9",
+		},
+	},
+	{
+		ID:       "2d4c6bb2-9f6b-4b1f-a1de-4c0e4f1e0b39",
+		SubnetID: "f6aa2d33-f3ae-4c4e-82f7-0d4ab4c67678",
+		IPAddrPool: virtualstorages.IPAddressPool{
+			Start: "192.168.2.10",
+			End:   "192.168.2.12",
+		},
+	},
+}
@@ -0,0 +1,336 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ipam"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/subnets"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+func TestNextSkipsUsedAddresses(t *testing.T) {
+	reserved := ipam.RangesFromVirtualStorages(Subnet.ID, VirtualStorages)
+	a, err := ipam.NewAllocator(Subnet, Ports, reserved...)
+	th.AssertNoErr(t, err)
+
+	ips, err := a.Next(3)
+	th.AssertNoErr(t, err)
+	defer a.Release(ips...)
+
+	// .2 is the DHCP port, .3 a host route next hop, .5 a port and .6-.9 a
+	// virtual storage pool. .4 belongs to another subnet's fixed IP.
+	th.CheckDeepEquals(t, []string{"192.168.2.4", "192.168.2.10", "192.168.2.11"}, ips)
+}
+
+func TestNextWithoutAllocationPools(t *testing.T) {
+	subnet := subnets.Subnet{
+		CIDR:      "10.1.0.0/29",
+		GatewayIP: "10.1.0.1",
+		ID:        "a5f5b6d5-4a3f-4a8c-9c6f-4a0b3e6c8a11",
+		IPVersion: 4,
+	}
+	a, err := ipam.NewAllocator(subnet, nil)
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, 5, a.Free())
+
+	ips, err := a.Next(5)
+	th.AssertNoErr(t, err)
+	defer a.Release(ips...)
+
+	th.CheckDeepEquals(t, []string{"10.1.0.2", "10.1.0.3", "10.1.0.4", "10.1.0.5", "10.1.0.6"}, ips)
+}
+
+func TestNextInsufficientAddresses(t *testing.T) {
+	a, err := ipam.NewAllocator(Subnet, Ports)
+	th.AssertNoErr(t, err)
+
+	free := a.Free()
+	_, err = a.Next(free + 1)
+	e, ok := err.(ipam.ErrInsufficientAddresses)
+	if !ok {
+		t.Fatalf("Expected ErrInsufficientAddresses, got %v", err)
+	}
+	th.CheckEquals(t, free, e.Available)
+	th.CheckEquals(t, free+1, e.Requested)
+
+	// A failed request must not hold any address.
+	th.CheckEquals(t, free, a.Free())
+}
+
+func TestCheck(t *testing.T) {
+	reserved := ipam.RangesFromVirtualStorages(Subnet.ID, VirtualStorages)
+	a, err := ipam.NewAllocator(Subnet, Ports, reserved...)
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, a.Check("192.168.2.15"))
+	th.AssertNoErr(t, a.Check("192.168.2.100"))
+
+	for _, address := range []string{"192.168.2.1", "192.168.2.3", "192.168.2.5", "192.168.2.7"} {
+		if _, ok := a.Check(address).(ipam.ErrAddressConflict); !ok {
+			t.Errorf("Expected ErrAddressConflict for %s", address)
+		}
+	}
+
+	for _, address := range []string{"192.168.2.0", "192.168.2.255", "192.168.3.1"} {
+		if _, ok := a.Check(address).(ipam.ErrAddressOutOfRange); !ok {
+			t.Errorf("Expected ErrAddressOutOfRange for %s", address)
+		}
+	}
+
+	if a.Check("not-an-ip") == nil {
+		t.Errorf("Expected error for an invalid address")
+	}
+}
+
+func TestReserveAndRelease(t *testing.T) {
+	a, err := ipam.NewAllocator(Subnet, Ports)
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, a.Reserve("192.168.2.15", "192.168.2.16"))
+	if _, ok := a.Check("192.168.2.15").(ipam.ErrAddressConflict); !ok {
+		t.Errorf("Expected ErrAddressConflict for a pending allocation")
+	}
+
+	// Reserve is all or nothing.
+	if err := a.Reserve("192.168.2.17", "192.168.2.16"); err == nil {
+		t.Errorf("Expected error, got none")
+	}
+	th.AssertNoErr(t, a.Check("192.168.2.17"))
+
+	// Held addresses belong to the allocator which handed them out.
+	b, err := ipam.NewAllocator(Subnet, Ports)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, b.Check("192.168.2.15"))
+
+	a.Release("192.168.2.15", "192.168.2.16")
+	th.AssertNoErr(t, a.Check("192.168.2.15"))
+}
+
+func TestSettle(t *testing.T) {
+	a, err := ipam.NewAllocator(Subnet, Ports)
+	th.AssertNoErr(t, err)
+	free := a.Free()
+
+	ips, err := a.Next(2)
+	th.AssertNoErr(t, err)
+	a.Settle(fmt.Errorf("create failed"), ips...)
+	th.CheckEquals(t, free, a.Free())
+
+	ips, err = a.Next(2)
+	th.AssertNoErr(t, err)
+	a.Settle(nil, ips...)
+	th.CheckEquals(t, free-2, a.Free())
+
+	// Confirmed addresses stay in use after Release.
+	a.Release(ips...)
+	if _, ok := a.Check(ips[0]).(ipam.ErrAddressConflict); !ok {
+		t.Errorf("Expected ErrAddressConflict for a confirmed allocation")
+	}
+}
+
+func TestHoldTimeout(t *testing.T) {
+	a, err := ipam.NewAllocator(Subnet, Ports)
+	th.AssertNoErr(t, err)
+	a.HoldTimeout = time.Millisecond
+
+	th.AssertNoErr(t, a.Reserve("192.168.2.15"))
+	time.Sleep(5 * time.Millisecond)
+	th.AssertNoErr(t, a.Check("192.168.2.15"))
+}
+
+func TestNextConcurrent(t *testing.T) {
+	a, err := ipam.NewAllocator(Subnet, nil)
+	th.AssertNoErr(t, err)
+
+	free := a.Free()
+	results := make(chan string, free)
+
+	var wg sync.WaitGroup
+	for i := 0; i < free; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ips, err := a.Next(1)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+			results <- ips[0]
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	seen := make(map[string]bool)
+	for ip := range results {
+		if seen[ip] {
+			t.Errorf("Address %s allocated twice", ip)
+		}
+		seen[ip] = true
+	}
+	th.CheckEquals(t, free, len(seen))
+	th.CheckEquals(t, 0, a.Free())
+}
+
+func TestNextClampsPoolToCIDR(t *testing.T) {
+	subnet := subnets.Subnet{
+		AllocationPools: []subnets.AllocationPool{
+			{Start: "10.1.0.0", End: "10.1.0.20"},
+			{Start: "10.1.1.1", End: "10.1.1.10"},
+		},
+		CIDR:      "10.1.0.0/29",
+		GatewayIP: "10.1.0.1",
+		ID:        "a5f5b6d5-4a3f-4a8c-9c6f-4a0b3e6c8a11",
+		IPVersion: 4,
+	}
+	a, err := ipam.NewAllocator(subnet, nil)
+	th.AssertNoErr(t, err)
+
+	// Only .2-.6 remain: .0 and .7 are the network and broadcast addresses,
+	// .1 is the gateway and the second pool lies outside the CIDR.
+	th.CheckEquals(t, 5, a.Free())
+
+	ips, err := a.Next(5)
+	th.AssertNoErr(t, err)
+	defer a.Release(ips...)
+
+	th.CheckDeepEquals(t, []string{"10.1.0.2", "10.1.0.3", "10.1.0.4", "10.1.0.5", "10.1.0.6"}, ips)
+}
+
+func TestNextSlash30(t *testing.T) {
+	subnet := subnets.Subnet{
+		AllocationPools: []subnets.AllocationPool{
+			{Start: "10.1.0.0", End: "10.1.0.3"},
+		},
+		CIDR:      "10.1.0.0/30",
+		GatewayIP: "10.1.0.1",
+		ID:        "a5f5b6d5-4a3f-4a8c-9c6f-4a0b3e6c8a11",
+		IPVersion: 4,
+	}
+	a, err := ipam.NewAllocator(subnet, nil)
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, 1, a.Free())
+
+	ips, err := a.Next(1)
+	th.AssertNoErr(t, err)
+	defer a.Release(ips...)
+	th.CheckDeepEquals(t, []string{"10.1.0.2"}, ips)
+
+	_, err = a.Next(1)
+	if _, ok := err.(ipam.ErrInsufficientAddresses); !ok {
+		t.Errorf("Expected ErrInsufficientAddresses, got %v", err)
+	}
+}
+
+func TestNextSlash31And32(t *testing.T) {
+	cases := []struct {
+		cidr     string
+		expected []string
+	}{
+		{"10.1.0.0/31", []string{"10.1.0.0", "10.1.0.1"}},
+		{"10.1.0.0/32", []string{"10.1.0.0"}},
+	}
+
+	for _, c := range cases {
+		subnet := subnets.Subnet{
+			CIDR:      c.cidr,
+			ID:        "a5f5b6d5-4a3f-4a8c-9c6f-4a0b3e6c8a11",
+			IPVersion: 4,
+		}
+		a, err := ipam.NewAllocator(subnet, nil)
+		th.AssertNoErr(t, err)
+
+		ips, err := a.Next(len(c.expected))
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, c.expected, ips)
+
+		a.Release(ips...)
+		for _, ip := range ips {
+			th.CheckNoErr(t, a.Check(ip))
+		}
+		th.CheckNoErr(t, a.Reserve(ips...))
+	}
+}
+
+func TestNextSkipsHostRouteDestinations(t *testing.T) {
+	subnet := subnets.Subnet{
+		CIDR:      "10.1.0.0/28",
+		GatewayIP: "10.1.0.1",
+		HostRoutes: []subnets.HostRoute{
+			{DestinationCIDR: "0.0.0.0/0", NextHop: "10.1.0.2"},
+			{DestinationCIDR: "10.1.0.4/30", NextHop: "10.1.0.3"},
+		},
+		ID:        "a5f5b6d5-4a3f-4a8c-9c6f-4a0b3e6c8a11",
+		IPVersion: 4,
+	}
+	a, err := ipam.NewAllocator(subnet, nil)
+	th.AssertNoErr(t, err)
+
+	if _, ok := a.Check("10.1.0.5").(ipam.ErrAddressConflict); !ok {
+		t.Errorf("Expected ErrAddressConflict for an address routed elsewhere")
+	}
+
+	ips, err := a.Next(1)
+	th.AssertNoErr(t, err)
+	defer a.Release(ips...)
+	th.CheckDeepEquals(t, []string{"10.1.0.8"}, ips)
+
+	subnet.HostRoutes = []subnets.HostRoute{{DestinationCIDR: "invalid", NextHop: "10.1.0.3"}}
+	if _, err := ipam.NewAllocator(subnet, nil); err == nil {
+		t.Errorf("Expected error for an invalid host route destination")
+	}
+}
+
+func TestNewAllocatorInvalidSubnet(t *testing.T) {
+	_, err := ipam.NewAllocator(subnets.Subnet{CIDR: "fd00::/64", IPVersion: 6}, nil)
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+
+	_, err = ipam.NewAllocator(subnets.Subnet{CIDR: "invalid"}, nil)
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestNewAllocatorFromClient(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets/ab49eb24-667f-4a4e-9421-b4d915bff416", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetSubnetResponse)
+	})
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"network_id": "8f36b88a-443f-4d97-9751-34d34af9e782"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListPortsResponse)
+	})
+
+	a, err := ipam.NewAllocatorFromClient(fake.ServiceClient(), "ab49eb24-667f-4a4e-9421-b4d915bff416")
+	th.AssertNoErr(t, err)
+
+	ips, err := a.Next(2)
+	th.AssertNoErr(t, err)
+	defer a.Release(ips...)
+
+	th.CheckDeepEquals(t, []string{"192.168.2.4", "192.168.2.5"}, ips)
+}
@@ -2,6 +2,7 @@ package networks
 
 import (
 	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/tags/selector"
 	"github.com/nttcom/eclcloud/v4/pagination"
 )
 
@@ -23,6 +24,21 @@ type ListOpts struct {
 	Plane       string `q:"plane"`
 	Status      string `q:"status"`
 	TenantID    string `q:"tenant_id"`
+
+	// Tags is a tag selector expression such as "env=prod,team!=ops". See
+	// package tags for how the results are filtered.
+	Tags string
+}
+
+// ListTagsBuilder is implemented by a ListOptsBuilder which also filters the
+// results by tags.
+type ListTagsBuilder interface {
+	ToNetworkListTags() (selector.Selector, error)
+}
+
+// ToNetworkListTags parses the Tags of a ListOpts.
+func (opts ListOpts) ToNetworkListTags() (selector.Selector, error) {
+	return selector.Parse(opts.Tags)
 }
 
 // ToNetworkListQuery formats a ListOpts into a query string.
@@ -36,15 +52,25 @@ func (opts ListOpts) ToNetworkListQuery() (string, error) {
 // the returned collection for greater efficiency.
 func List(c *eclcloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	url := listURL(c)
+	var tags selector.Selector
 	if opts != nil {
 		query, err := opts.ToNetworkListQuery()
 		if err != nil {
 			return pagination.Pager{Err: err}
 		}
 		url += query
+
+		if b, ok := opts.(ListTagsBuilder); ok {
+			tags, err = b.ToNetworkListTags()
+			if err != nil {
+				return pagination.Pager{Err: err}
+			}
+		}
 	}
 	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
-		return NetworkPage{pagination.LinkedPageBase{PageResult: r}}
+		var filtered bool
+		r.Body, filtered = tags.FilterBody(r.Body, "networks")
+		return NetworkPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}, filtered: filtered}
 	})
 }
 
@@ -84,6 +84,9 @@ type Network struct {
 // collection of networks.
 type NetworkPage struct {
 	pagination.LinkedPageBase
+
+	// filtered is set when tags removed networks from the page.
+	filtered bool
 }
 
 // NextPageURL is invoked when a paginated collection of networks has reached
@@ -102,6 +105,9 @@ func (r NetworkPage) NextPageURL() (string, error) {
 
 // IsEmpty checks whether a NetworkPage struct is empty.
 func (r NetworkPage) IsEmpty() (bool, error) {
+	if r.filtered {
+		return false, nil
+	}
 	is, err := ExtractNetworks(r)
 	return len(is) == 0, err
 }
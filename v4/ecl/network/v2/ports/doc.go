@@ -34,7 +34,7 @@ Example to Create a Port
 		Name:         "private-port",
 		AdminStateUp: &asu,
 		NetworkID:    "a87cc70a-3e15-4acf-8205-9b711a3531b7",
-		FixedIPs: []ports.IP{
+		FixedIPs: []ports.FixedIP{
 			{SubnetID: "a0304c3a-4f08-4c43-88af-d796509c97d2", IPAddress: "10.0.0.2"},
 		},
 		SecurityGroups: &[]string{"foo"},
@@ -62,6 +62,20 @@ Example to Update a Port
 		panic(err)
 	}
 
+Example to Add a Fixed IP to a Port
+
+	portID := "c34bae2b-7641-49b6-bf6d-d8e473620ed8"
+
+	fixedIP := ports.FixedIP{
+		SubnetID:  "a0304c3a-4f08-4c43-88af-d796509c97d2",
+		IPAddress: "10.0.0.3",
+	}
+
+	port, err := ports.AddFixedIP(networkClient, portID, fixedIP)
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Port
 
 	portID := "c34bae2b-7641-49b6-bf6d-d8e473620ed8"
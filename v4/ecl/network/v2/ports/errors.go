@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrEntryConflict is the error when a fixed IP or allowed address pair
+// cannot be added to a port because the port already has an entry for the
+// same IP address.
+type ErrEntryConflict struct {
+	eclcloud.BaseError
+	PortID    string
+	Field     string
+	IPAddress string
+}
+
+func (e ErrEntryConflict) Error() string {
+	return fmt.Sprintf("Port [%s] already has %s entry for IP address [%s]", e.PortID, e.Field, e.IPAddress)
+}
+
+// ErrEntryNotFound is the error when a fixed IP or allowed address pair to
+// be removed or replaced is not present on a port.
+type ErrEntryNotFound struct {
+	eclcloud.BaseError
+	PortID string
+	Field  string
+	Entry  interface{}
+}
+
+func (e ErrEntryNotFound) Error() string {
+	return fmt.Sprintf("Port [%s] has no %s entry matching [%+v]", e.PortID, e.Field, e.Entry)
+}
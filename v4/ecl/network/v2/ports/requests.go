@@ -2,6 +2,7 @@ package ports
 
 import (
 	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/tags/selector"
 	"github.com/nttcom/eclcloud/v4/pagination"
 )
 
@@ -28,6 +29,21 @@ type ListOpts struct {
 	SegmentationType string `q:"segmentation_type"`
 	Status           string `q:"status"`
 	TenantID         string `q:"tenant_id"`
+
+	// Tags is a tag selector expression such as "env=prod,team!=ops". See
+	// package tags for how the results are filtered.
+	Tags string
+}
+
+// ListTagsBuilder is implemented by a ListOptsBuilder which also filters the
+// results by tags.
+type ListTagsBuilder interface {
+	ToPortListTags() (selector.Selector, error)
+}
+
+// ToPortListTags parses the Tags of a ListOpts.
+func (opts ListOpts) ToPortListTags() (selector.Selector, error) {
+	return selector.Parse(opts.Tags)
 }
 
 // ToPortListQuery formats a ListOpts into a query string.
@@ -45,15 +61,25 @@ func (opts ListOpts) ToPortListQuery() (string, error) {
 // administrative rights.
 func List(c *eclcloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	url := listURL(c)
+	var tags selector.Selector
 	if opts != nil {
 		query, err := opts.ToPortListQuery()
 		if err != nil {
 			return pagination.Pager{Err: err}
 		}
 		url += query
+
+		if b, ok := opts.(ListTagsBuilder); ok {
+			tags, err = b.ToPortListTags()
+			if err != nil {
+				return pagination.Pager{Err: err}
+			}
+		}
 	}
 	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
-		return PortPage{pagination.LinkedPageBase{PageResult: r}}
+		var filtered bool
+		r.Body, filtered = tags.FilterBody(r.Body, "ports")
+		return PortPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}, filtered: filtered}
 	})
 }
 
@@ -70,6 +96,10 @@ type CreateOptsBuilder interface {
 }
 
 // CreateOpts represents the attributes used when creating a new port.
+//
+// FixedIPs is usually a []FixedIP, which is checked before the request is
+// sent. Any other value which marshals to a list of fixed IPs, such as a
+// []map[string]interface{}, is sent as is.
 type CreateOpts struct {
 	AdminStateUp        *bool             `json:"admin_state_up,omitempty"`
 	AllowedAddressPairs []AddressPair     `json:"allowed_address_pairs,omitempty"`
@@ -80,6 +110,7 @@ type CreateOpts struct {
 	MACAddress          string            `json:"mac_address,omitempty"`
 	Name                string            `json:"name,omitempty"`
 	NetworkID           string            `json:"network_id"`
+	SecurityGroups      *[]string         `json:"security_groups,omitempty"`
 	SegmentationID      int               `json:"segmentation_id,omitempty"`
 	SegmentationType    string            `json:"segmentation_type,omitempty"`
 	Tags                map[string]string `json:"tags,omitempty"`
@@ -88,6 +119,9 @@ type CreateOpts struct {
 
 // ToPortCreateMap builds a request body from CreateOpts.
 func (opts CreateOpts) ToPortCreateMap() (map[string]interface{}, error) {
+	if err := checkFixedIPs(opts.FixedIPs); err != nil {
+		return nil, err
+	}
 	return eclcloud.BuildRequestBody(opts, "port")
 }
 
@@ -110,6 +144,8 @@ type UpdateOptsBuilder interface {
 }
 
 // UpdateOpts represents the attributes used when updating an existing port.
+// FixedIPs replaces all fixed IPs of the port, and accepts the same values as
+// CreateOpts.FixedIPs.
 type UpdateOpts struct {
 	AdminStateUp        *bool              `json:"admin_state_up,omitempty"`
 	AllowedAddressPairs *[]AddressPair     `json:"allowed_address_pairs,omitempty"`
@@ -118,6 +154,7 @@ type UpdateOpts struct {
 	DeviceOwner         *string            `json:"device_owner,omitempty"`
 	FixedIPs            interface{}        `json:"fixed_ips,omitempty"`
 	Name                *string            `json:"name,omitempty"`
+	SecurityGroups      *[]string          `json:"security_groups,omitempty"`
 	SegmentationID      *int               `json:"segmentation_id,omitempty"`
 	SegmentationType    *string            `json:"segmentation_type,omitempty"`
 	Tags                *map[string]string `json:"tags,omitempty"`
@@ -125,6 +162,9 @@ type UpdateOpts struct {
 
 // ToPortUpdateMap builds a request body from UpdateOpts.
 func (opts UpdateOpts) ToPortUpdateMap() (map[string]interface{}, error) {
+	if err := checkFixedIPs(opts.FixedIPs); err != nil {
+		return nil, err
+	}
 	return eclcloud.BuildRequestBody(opts, "port")
 }
 
@@ -44,12 +44,18 @@ type DeleteResult struct {
 	eclcloud.ErrResult
 }
 
-// IP is a sub-struct that represents an individual IP.
-type IP struct {
-	SubnetID  string `json:"subnet_id"`
+// FixedIP is an IP address of a port together with the subnet it belongs
+// to. When creating or updating a port, either field may be left empty: an
+// empty IPAddress allocates the next free address of SubnetID, and an empty
+// SubnetID selects the subnet containing IPAddress.
+type FixedIP struct {
+	SubnetID  string `json:"subnet_id,omitempty"`
 	IPAddress string `json:"ip_address,omitempty"`
 }
 
+// IP is the former name of FixedIP.
+type IP = FixedIP
+
 // AddressPair contains the IP Address and the MAC address.
 type AddressPair struct {
 	IPAddress  string `json:"ip_address,omitempty"`
@@ -77,7 +83,7 @@ type Port struct {
 
 	// Specifies IP addresses for the port thus associating the port itself with
 	// the subnets where the IP addresses are picked from
-	FixedIPs []IP `json:"fixed_ips"`
+	FixedIPs []FixedIP `json:"fixed_ips"`
 
 	// UUID for the port.
 	ID string `json:"id"`
@@ -94,6 +100,10 @@ type Port struct {
 	// Network that this port is associated with.
 	NetworkID string `json:"network_id"`
 
+	// SecurityGroups is the list of security group IDs associated with this
+	// port.
+	SecurityGroups []string `json:"security_groups"`
+
 	// SegmentationID is the segmenation ID used for this port (i.e. for vlan type it is vlan tag)
 	SegmentationID int `json:"segmentation_id"`
 
@@ -116,6 +126,9 @@ type Port struct {
 // of network ports.
 type PortPage struct {
 	pagination.LinkedPageBase
+
+	// filtered is set when tags removed ports from the page.
+	filtered bool
 }
 
 // NextPageURL is invoked when a paginated collection of ports has reached
@@ -134,6 +147,9 @@ func (r PortPage) NextPageURL() (string, error) {
 
 // IsEmpty checks whether a PortPage struct is empty.
 func (r PortPage) IsEmpty() (bool, error) {
+	if r.filtered {
+		return false, nil
+	}
 	is, err := ExtractPorts(r)
 	return len(is) == 0, err
 }
@@ -242,6 +242,46 @@ const RemoveAllowedAddressPairsResponse = `
   }
 `
 
+const UpdateSecurityGroupsRequest = `
+{
+	"port": {
+		"security_groups": [
+			"85cc3048-abc3-43cc-89b3-377341426ac5"
+		]
+	}
+  }
+`
+
+const UpdateSecurityGroupsResponse = `
+{
+	"port": {
+	  "admin_state_up": true,
+	  "allowed_address_pairs": [],
+	  "description": "",
+	  "device_id": "",
+	  "device_owner": "",
+	  "fixed_ips": [
+		{
+		  "ip_address": "192.168.2.30",
+		  "subnet_id": "ab49eb24-667f-4a4e-9421-b4d915bff416"
+		}
+	  ],
+	  "id": "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730",
+	  "mac_address": "fa:16:3e:b0:ca:f1",
+	  "name": "port_12",
+	  "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+	  "security_groups": [
+		"85cc3048-abc3-43cc-89b3-377341426ac5"
+	  ],
+	  "segmentation_id": 0,
+	  "segmentation_type": "flat",
+	  "status": "ACTIVE",
+	  "tags": {},
+	  "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	}
+  }
+`
+
 var Port1 = ports.Port{
 	AdminStateUp:        true,
 	AllowedAddressPairs: []ports.AddressPair{},
@@ -288,3 +328,103 @@ var Port2 = ports.Port{
 }
 
 var ExpectedPortSlice = []ports.Port{Port1, Port2}
+
+const AddFixedIPRequest = `
+{
+  "port": {
+    "fixed_ips": [
+      {
+        "ip_address": "192.168.2.30",
+        "subnet_id": "ab49eb24-667f-4a4e-9421-b4d915bff416"
+      },
+      {
+        "ip_address": "192.168.2.31",
+        "subnet_id": "ab49eb24-667f-4a4e-9421-b4d915bff416"
+      }
+    ]
+  }
+}
+`
+
+const AddFixedIPResponse = `
+{
+  "port": {
+    "admin_state_up": true,
+    "allowed_address_pairs": [
+      {
+        "ip_address": "192.168.2.100",
+        "mac_address": "00:00:5e:00:01:01"
+      }
+    ],
+    "description": "",
+    "device_id": "",
+    "device_owner": "",
+    "fixed_ips": [
+      {
+        "ip_address": "192.168.2.30",
+        "subnet_id": "ab49eb24-667f-4a4e-9421-b4d915bff416"
+      },
+      {
+        "ip_address": "192.168.2.31",
+        "subnet_id": "ab49eb24-667f-4a4e-9421-b4d915bff416"
+      }
+    ],
+    "id": "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730",
+    "mac_address": "fa:16:3e:b0:ca:f1",
+    "managed_by_service": false,
+    "name": "port_12",
+    "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+    "segmentation_id": 0,
+    "segmentation_type": "flat",
+    "status": "ACTIVE",
+    "tags": {},
+    "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+  }
+}
+`
+
+const ReplaceAllowedAddressPairRequest = `
+{
+  "port": {
+    "allowed_address_pairs": [
+      {
+        "ip_address": "192.168.2.101",
+        "mac_address": "00:00:5e:00:01:01"
+      }
+    ]
+  }
+}
+`
+
+const ReplaceAllowedAddressPairResponse = `
+{
+  "port": {
+    "admin_state_up": true,
+    "allowed_address_pairs": [
+      {
+        "ip_address": "192.168.2.101",
+        "mac_address": "00:00:5e:00:01:01"
+      }
+    ],
+    "description": "",
+    "device_id": "",
+    "device_owner": "",
+    "fixed_ips": [
+      {
+        "ip_address": "192.168.2.30",
+        "subnet_id": "ab49eb24-667f-4a4e-9421-b4d915bff416"
+      }
+    ],
+    "id": "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730",
+    "mac_address": "fa:16:3e:b0:ca:f1",
+    "managed_by_service": false,
+    "name": "port_12",
+    "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+    "segmentation_id": 0,
+    "segmentation_type": "flat",
+    "status": "ACTIVE",
+    "tags": {},
+    "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+  }
+}
+`
@@ -3,8 +3,10 @@ package testing
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 
+	"github.com/nttcom/eclcloud/v4"
 	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
 	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
 	"github.com/nttcom/eclcloud/v4/pagination"
@@ -206,6 +208,33 @@ func TestRemoveAllowedAddressPairs(t *testing.T) {
 	th.AssertDeepEquals(t, s.AllowedAddressPairs, []ports.AddressPair{})
 }
 
+func TestUpdatePortSecurityGroups(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports/ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateSecurityGroupsRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, UpdateSecurityGroupsResponse)
+	})
+
+	options := ports.UpdateOpts{
+		SecurityGroups: &[]string{"85cc3048-abc3-43cc-89b3-377341426ac5"},
+	}
+
+	p, err := ports.Update(fake.ServiceClient(), "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730", options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []string{"85cc3048-abc3-43cc-89b3-377341426ac5"}, p.SecurityGroups)
+}
+
 func TestDeletePort(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -219,3 +248,181 @@ func TestDeletePort(t *testing.T) {
 	res := ports.Delete(fake.ServiceClient(), "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestCreatePortWithRawFixedIPs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, CreateResponse)
+	})
+
+	asu := true
+
+	options := ports.CreateOpts{
+		AdminStateUp: &asu,
+		AllowedAddressPairs: []ports.AddressPair{{
+			IPAddress:  "192.168.2.100",
+			MACAddress: "00:00:5e:00:01:01",
+		}},
+		FixedIPs: []map[string]interface{}{{
+			"ip_address": "192.168.2.30",
+			"subnet_id":  "ab49eb24-667f-4a4e-9421-b4d915bff416",
+		}},
+		Name:             "port_12",
+		NetworkID:        "8f36b88a-443f-4d97-9751-34d34af9e782",
+		TenantID:         "dcb2d589c0c646d0bad45c0cf9f90cf1",
+		SegmentationType: "flat",
+	}
+	p, err := ports.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &Port2, p)
+}
+
+func TestCreatePortInvalidFixedIPs(t *testing.T) {
+	tests := []struct {
+		fixedIPs []ports.FixedIP
+		err      interface{}
+	}{
+		{[]ports.FixedIP{{}}, eclcloud.ErrMissingInput{}},
+		{[]ports.FixedIP{{IPAddress: "192.168.2.300"}}, eclcloud.ErrInvalidInput{}},
+		{[]ports.FixedIP{{IPAddress: "192.168.2.30"}, {IPAddress: "192.168.2.30"}}, eclcloud.ErrInvalidInput{}},
+	}
+
+	for _, test := range tests {
+		options := ports.CreateOpts{
+			NetworkID: "8f36b88a-443f-4d97-9751-34d34af9e782",
+			FixedIPs:  test.fixedIPs,
+		}
+		_, err := options.ToPortCreateMap()
+		if reflect.TypeOf(err) != reflect.TypeOf(test.err) {
+			t.Errorf("Expected %T for %+v, got %#v", test.err, test.fixedIPs, err)
+		}
+	}
+}
+
+func TestAddFixedIP(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports/ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, GetResponse)
+		case "PUT":
+			th.TestJSONRequest(t, r, AddFixedIPRequest)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, AddFixedIPResponse)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	p, err := ports.AddFixedIP(fake.ServiceClient(), "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730", ports.FixedIP{
+		SubnetID:  "ab49eb24-667f-4a4e-9421-b4d915bff416",
+		IPAddress: "192.168.2.31",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(p.FixedIPs))
+	th.AssertEquals(t, "192.168.2.31", p.FixedIPs[1].IPAddress)
+}
+
+func TestFixedIPConflicts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports/ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetResponse)
+	})
+
+	client := fake.ServiceClient()
+	id := "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730"
+
+	_, err := ports.AddFixedIP(client, id, ports.FixedIP{IPAddress: "192.168.2.30"})
+	if _, ok := err.(ports.ErrEntryConflict); !ok {
+		t.Errorf("Expected ErrEntryConflict, got %#v", err)
+	}
+
+	_, err = ports.RemoveFixedIP(client, id, ports.FixedIP{IPAddress: "192.168.2.31"})
+	if _, ok := err.(ports.ErrEntryNotFound); !ok {
+		t.Errorf("Expected ErrEntryNotFound, got %#v", err)
+	}
+
+	_, err = ports.ReplaceAllowedAddressPair(client, id,
+		ports.AddressPair{IPAddress: "192.168.2.200"},
+		ports.AddressPair{IPAddress: "192.168.2.201"},
+	)
+	if _, ok := err.(ports.ErrEntryNotFound); !ok {
+		t.Errorf("Expected ErrEntryNotFound, got %#v", err)
+	}
+
+	_, err = ports.AddAllowedAddressPair(client, id, ports.AddressPair{IPAddress: "192.168.2.100"})
+	if _, ok := err.(ports.ErrEntryConflict); !ok {
+		t.Errorf("Expected ErrEntryConflict, got %#v", err)
+	}
+}
+
+func TestRemoveEmptyPattern(t *testing.T) {
+	client := fake.ServiceClient()
+	id := "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730"
+
+	// An empty pattern would match every entry, so it is rejected before
+	// the port is read.
+	_, err := ports.RemoveFixedIP(client, id, ports.IP{})
+	if _, ok := err.(eclcloud.ErrMissingInput); !ok {
+		t.Errorf("Expected ErrMissingInput, got %#v", err)
+	}
+
+	_, err = ports.RemoveAllowedAddressPair(client, id, ports.AddressPair{})
+	if _, ok := err.(eclcloud.ErrMissingInput); !ok {
+		t.Errorf("Expected ErrMissingInput, got %#v", err)
+	}
+}
+
+func TestReplaceAllowedAddressPair(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports/ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, GetResponse)
+		case "PUT":
+			th.TestJSONRequest(t, r, ReplaceAllowedAddressPairRequest)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ReplaceAllowedAddressPairResponse)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	p, err := ports.ReplaceAllowedAddressPair(fake.ServiceClient(), "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730",
+		ports.AddressPair{IPAddress: "192.168.2.100"},
+		ports.AddressPair{IPAddress: "192.168.2.101", MACAddress: "00:00:5e:00:01:01"},
+	)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []ports.AddressPair{{
+		IPAddress:  "192.168.2.101",
+		MACAddress: "00:00:5e:00:01:01",
+	}}, p.AllowedAddressPairs)
+}
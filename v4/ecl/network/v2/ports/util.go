@@ -0,0 +1,249 @@
+package ports
+
+import (
+	"net"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// checkFixedIPs validates fixed IPs given as a []FixedIP. Values of any other
+// type are left to the API to validate.
+func checkFixedIPs(v interface{}) error {
+	fixedIPs, ok := v.([]FixedIP)
+	if !ok {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, ip := range fixedIPs {
+		if ip.SubnetID == "" && ip.IPAddress == "" {
+			err := eclcloud.ErrMissingInput{}
+			err.Argument = "ports.FixedIP.SubnetID"
+			return err
+		}
+		if ip.IPAddress == "" {
+			continue
+		}
+		if net.ParseIP(ip.IPAddress) == nil {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "ports.FixedIP.IPAddress"
+			err.Value = ip.IPAddress
+			return err
+		}
+		if seen[ip.IPAddress] {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "ports.FixedIP.IPAddress"
+			err.Value = ip.IPAddress
+			err.Info = "IP address " + ip.IPAddress + " is given more than once"
+			return err
+		}
+		seen[ip.IPAddress] = true
+	}
+
+	return nil
+}
+
+// matches reports whether ip matches the given pattern. Empty fields of the
+// pattern match any value.
+func (ip FixedIP) matches(pattern FixedIP) bool {
+	return (pattern.SubnetID == "" || pattern.SubnetID == ip.SubnetID) &&
+		(pattern.IPAddress == "" || pattern.IPAddress == ip.IPAddress)
+}
+
+func (p AddressPair) matches(pattern AddressPair) bool {
+	return (pattern.IPAddress == "" || pattern.IPAddress == p.IPAddress) &&
+		(pattern.MACAddress == "" || pattern.MACAddress == p.MACAddress)
+}
+
+// AddFixedIP reads the port, adds the given fixed IP and updates the port.
+// It returns ErrEntryConflict if the port already has the IP address.
+func AddFixedIP(c *eclcloud.ServiceClient, id string, ip FixedIP) (*Port, error) {
+	return updateFixedIPs(c, id, func(current []FixedIP) ([]FixedIP, error) {
+		if err := checkFixedIPConflict(id, current, ip); err != nil {
+			return nil, err
+		}
+		return append(current, ip), nil
+	})
+}
+
+// RemoveFixedIP reads the port, removes the fixed IPs matching ip and updates
+// the port. Empty fields of ip match any value, so a FixedIP with only a
+// SubnetID removes every address of that subnet. It returns ErrMissingInput
+// if ip has no fields set, and ErrEntryNotFound if nothing matches.
+func RemoveFixedIP(c *eclcloud.ServiceClient, id string, ip FixedIP) (*Port, error) {
+	if err := checkFixedIPPattern(ip); err != nil {
+		return nil, err
+	}
+	return updateFixedIPs(c, id, func(current []FixedIP) ([]FixedIP, error) {
+		kept := []FixedIP{}
+		for _, existing := range current {
+			if !existing.matches(ip) {
+				kept = append(kept, existing)
+			}
+		}
+		if len(kept) == len(current) {
+			return nil, ErrEntryNotFound{PortID: id, Field: "fixed_ips", Entry: ip}
+		}
+		return kept, nil
+	})
+}
+
+// ReplaceFixedIP reads the port, replaces the first fixed IP matching old
+// with replacement and updates the port. It returns ErrEntryNotFound if
+// nothing matches old, and ErrEntryConflict if another fixed IP of the port
+// already has the replacement's IP address.
+func ReplaceFixedIP(c *eclcloud.ServiceClient, id string, old, replacement FixedIP) (*Port, error) {
+	if err := checkFixedIPPattern(old); err != nil {
+		return nil, err
+	}
+	return updateFixedIPs(c, id, func(current []FixedIP) ([]FixedIP, error) {
+		for i, existing := range current {
+			if !existing.matches(old) {
+				continue
+			}
+			others := append(append([]FixedIP{}, current[:i]...), current[i+1:]...)
+			if err := checkFixedIPConflict(id, others, replacement); err != nil {
+				return nil, err
+			}
+			replaced := append([]FixedIP{}, current...)
+			replaced[i] = replacement
+			return replaced, nil
+		}
+		return nil, ErrEntryNotFound{PortID: id, Field: "fixed_ips", Entry: old}
+	})
+}
+
+// AddAllowedAddressPair reads the port, adds the given allowed address pair
+// and updates the port. It returns ErrEntryConflict if the port already has
+// a pair for the IP address.
+func AddAllowedAddressPair(c *eclcloud.ServiceClient, id string, pair AddressPair) (*Port, error) {
+	return updateAllowedAddressPairs(c, id, func(current []AddressPair) ([]AddressPair, error) {
+		if err := checkAddressPairConflict(id, current, pair); err != nil {
+			return nil, err
+		}
+		return append(current, pair), nil
+	})
+}
+
+// RemoveAllowedAddressPair reads the port, removes the allowed address pairs
+// matching pair and updates the port. Empty fields of pair match any value.
+// It returns ErrMissingInput if pair has no fields set, and ErrEntryNotFound
+// if nothing matches.
+func RemoveAllowedAddressPair(c *eclcloud.ServiceClient, id string, pair AddressPair) (*Port, error) {
+	if err := checkAddressPairPattern(pair); err != nil {
+		return nil, err
+	}
+	return updateAllowedAddressPairs(c, id, func(current []AddressPair) ([]AddressPair, error) {
+		kept := []AddressPair{}
+		for _, existing := range current {
+			if !existing.matches(pair) {
+				kept = append(kept, existing)
+			}
+		}
+		if len(kept) == len(current) {
+			return nil, ErrEntryNotFound{PortID: id, Field: "allowed_address_pairs", Entry: pair}
+		}
+		return kept, nil
+	})
+}
+
+// ReplaceAllowedAddressPair reads the port, replaces the first allowed
+// address pair matching old with replacement and updates the port. It returns
+// ErrEntryNotFound if nothing matches old, and ErrEntryConflict if another
+// pair of the port already has the replacement's IP address.
+func ReplaceAllowedAddressPair(c *eclcloud.ServiceClient, id string, old, replacement AddressPair) (*Port, error) {
+	if err := checkAddressPairPattern(old); err != nil {
+		return nil, err
+	}
+	return updateAllowedAddressPairs(c, id, func(current []AddressPair) ([]AddressPair, error) {
+		for i, existing := range current {
+			if !existing.matches(old) {
+				continue
+			}
+			others := append(append([]AddressPair{}, current[:i]...), current[i+1:]...)
+			if err := checkAddressPairConflict(id, others, replacement); err != nil {
+				return nil, err
+			}
+			replaced := append([]AddressPair{}, current...)
+			replaced[i] = replacement
+			return replaced, nil
+		}
+		return nil, ErrEntryNotFound{PortID: id, Field: "allowed_address_pairs", Entry: old}
+	})
+}
+
+// checkFixedIPPattern returns ErrMissingInput for a pattern with no fields
+// set, which would match every fixed IP of the port.
+func checkFixedIPPattern(ip FixedIP) error {
+	if ip.IPAddress == "" && ip.SubnetID == "" {
+		err := eclcloud.ErrMissingInput{}
+		err.Argument = "ports.FixedIP.IPAddress"
+		return err
+	}
+	return nil
+}
+
+// checkAddressPairPattern returns ErrMissingInput for a pattern with no
+// fields set, which would match every allowed address pair of the port.
+func checkAddressPairPattern(pair AddressPair) error {
+	if pair.IPAddress == "" && pair.MACAddress == "" {
+		err := eclcloud.ErrMissingInput{}
+		err.Argument = "ports.AddressPair.IPAddress"
+		return err
+	}
+	return nil
+}
+
+func checkFixedIPConflict(id string, current []FixedIP, ip FixedIP) error {
+	if ip.IPAddress == "" {
+		return nil
+	}
+	for _, existing := range current {
+		if existing.IPAddress == ip.IPAddress {
+			return ErrEntryConflict{PortID: id, Field: "fixed_ips", IPAddress: ip.IPAddress}
+		}
+	}
+	return nil
+}
+
+func checkAddressPairConflict(id string, current []AddressPair, pair AddressPair) error {
+	if pair.IPAddress == "" {
+		err := eclcloud.ErrMissingInput{}
+		err.Argument = "ports.AddressPair.IPAddress"
+		return err
+	}
+	for _, existing := range current {
+		if existing.IPAddress == pair.IPAddress {
+			return ErrEntryConflict{PortID: id, Field: "allowed_address_pairs", IPAddress: pair.IPAddress}
+		}
+	}
+	return nil
+}
+
+func updateFixedIPs(c *eclcloud.ServiceClient, id string, modify func([]FixedIP) ([]FixedIP, error)) (*Port, error) {
+	port, err := Get(c, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	fixedIPs, err := modify(append([]FixedIP{}, port.FixedIPs...))
+	if err != nil {
+		return nil, err
+	}
+
+	return Update(c, id, UpdateOpts{FixedIPs: fixedIPs}).Extract()
+}
+
+func updateAllowedAddressPairs(c *eclcloud.ServiceClient, id string, modify func([]AddressPair) ([]AddressPair, error)) (*Port, error) {
+	port, err := Get(c, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	pairs, err := modify(append([]AddressPair{}, port.AllowedAddressPairs...))
+	if err != nil {
+		return nil, err
+	}
+
+	return Update(c, id, UpdateOpts{AllowedAddressPairs: &pairs}).Extract()
+}
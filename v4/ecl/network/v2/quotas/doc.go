@@ -0,0 +1,40 @@
+/*
+Package quotas provides the ability to retrieve and manage Network quotas of
+a tenant.
+
+Example to Get Tenant Quotas
+
+	tenantID := "23d5d3f79dfa4f73b72b8b0b0063ec55"
+	quotasInfo, err := quotas.Get(networkClient, tenantID).Extract()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("quotas: %#v\n", quotasInfo)
+
+Example to Get Tenant Quota Usage
+
+	tenantID := "23d5d3f79dfa4f73b72b8b0b0063ec55"
+	detail, err := quotas.GetDetail(networkClient, tenantID).Extract()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("%d of %d ports in use\n", detail.Port.Used, detail.Port.Limit)
+
+Example to Update Tenant Quotas
+
+	tenantID := "23d5d3f79dfa4f73b72b8b0b0063ec55"
+
+	updateOpts := quotas.UpdateOpts{
+		Network: eclcloud.IntToPointer(20),
+		Port:    eclcloud.IntToPointer(100),
+	}
+	quotasInfo, err := quotas.Update(networkClient, tenantID, updateOpts).Extract()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("quotas: %#v\n", quotasInfo)
+*/
+package quotas
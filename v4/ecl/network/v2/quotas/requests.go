@@ -0,0 +1,68 @@
+package quotas
+
+import "github.com/nttcom/eclcloud/v4"
+
+// Get returns Network Quotas for a tenant.
+func Get(client *eclcloud.ServiceClient, tenantID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, tenantID), &r.Body, nil)
+	return
+}
+
+// GetDetail returns detailed Network Quotas for a tenant, including the
+// number of resources in use.
+func GetDetail(client *eclcloud.ServiceClient, tenantID string) (r GetDetailResult) {
+	_, r.Err = client.Get(getDetailURL(client, tenantID), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToQuotaUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents options used to update the Network Quotas.
+// Quotas left nil are unchanged, and -1 removes the limit of a resource.
+type UpdateOpts struct {
+	// Network represents a number of networks. A "-1" value means no limit.
+	Network *int `json:"network,omitempty"`
+
+	// Port represents a number of ports. A "-1" value means no limit.
+	Port *int `json:"port,omitempty"`
+
+	// SecurityGroupRule represents a number of security group rules.
+	// A "-1" value means no limit.
+	SecurityGroupRule *int `json:"security_group_rule,omitempty"`
+
+	// SecurityGroup represents a number of security groups.
+	// A "-1" value means no limit.
+	SecurityGroup *int `json:"security_group,omitempty"`
+
+	// Subnet represents a number of subnets. A "-1" value means no limit.
+	Subnet *int `json:"subnet,omitempty"`
+}
+
+// ToQuotaUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToQuotaUpdateMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "quota")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing Network Quotas
+// using the values provided.
+func Update(c *eclcloud.ServiceClient, tenantID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToQuotaUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(updateURL(c, tenantID), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete resets the Network Quotas of a tenant to the defaults.
+func Delete(c *eclcloud.ServiceClient, tenantID string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, tenantID), nil)
+	return
+}
@@ -0,0 +1,99 @@
+package quotas
+
+import "github.com/nttcom/eclcloud/v4"
+
+type commonResult struct {
+	eclcloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Quota resource.
+func (r commonResult) Extract() (*Quota, error) {
+	var s struct {
+		Quota *Quota `json:"quota"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Quota, err
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Quota.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a Quota.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	eclcloud.ErrResult
+}
+
+// GetDetailResult represents the result of a get detail operation. Call its
+// Extract method to interpret it as a QuotaDetailSet.
+type GetDetailResult struct {
+	eclcloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a QuotaDetailSet.
+func (r GetDetailResult) Extract() (*QuotaDetailSet, error) {
+	var s struct {
+		Quota *QuotaDetailSet `json:"quota"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Quota, err
+}
+
+// Quota contains Network quotas for a tenant. A limit of -1 means the
+// resource is unlimited.
+type Quota struct {
+	// Network represents a number of networks.
+	Network int `json:"network"`
+
+	// Port represents a number of ports.
+	Port int `json:"port"`
+
+	// SecurityGroupRule represents a number of security group rules.
+	SecurityGroupRule int `json:"security_group_rule"`
+
+	// SecurityGroup represents a number of security groups.
+	SecurityGroup int `json:"security_group"`
+
+	// Subnet represents a number of subnets.
+	Subnet int `json:"subnet"`
+}
+
+// QuotaDetail shows the limit and the usage of a single Network resource.
+type QuotaDetail struct {
+	// Used is the number of resources in use.
+	Used int `json:"used"`
+
+	// Reserved is the number of resources reserved for requests in progress.
+	Reserved int `json:"reserved"`
+
+	// Limit is the maximum number of resources, or -1 when unlimited.
+	Limit int `json:"limit"`
+}
+
+// QuotaDetailSet contains the limits and usage of the Network resources of a
+// tenant.
+type QuotaDetailSet struct {
+	// Network represents the networks.
+	Network QuotaDetail `json:"network"`
+
+	// Port represents the ports.
+	Port QuotaDetail `json:"port"`
+
+	// SecurityGroupRule represents the security group rules.
+	SecurityGroupRule QuotaDetail `json:"security_group_rule"`
+
+	// SecurityGroup represents the security groups.
+	SecurityGroup QuotaDetail `json:"security_group"`
+
+	// Subnet represents the subnets.
+	Subnet QuotaDetail `json:"subnet"`
+}
@@ -0,0 +1,2 @@
+// quotas unit tests
+package testing
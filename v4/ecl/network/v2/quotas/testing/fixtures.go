@@ -0,0 +1,96 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/quotas"
+)
+
+const TenantID = "0749f8f3b5d74fd0aad4fb0d11d0e4e3"
+
+const GetResponse = `
+{
+    "quota": {
+        "network": 10,
+        "port": 50,
+        "security_group": 10,
+        "security_group_rule": 100,
+        "subnet": 20
+    }
+}
+`
+
+const GetDetailResponse = `
+{
+    "quota": {
+        "network": {
+            "used": 2,
+            "reserved": 0,
+            "limit": 10
+        },
+        "port": {
+            "used": 48,
+            "reserved": 1,
+            "limit": 50
+        },
+        "security_group": {
+            "used": 1,
+            "reserved": 0,
+            "limit": 10
+        },
+        "security_group_rule": {
+            "used": 4,
+            "reserved": 0,
+            "limit": 100
+        },
+        "subnet": {
+            "used": 2,
+            "reserved": 0,
+            "limit": -1
+        }
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "quota": {
+        "network": 20,
+        "port": 100
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "quota": {
+        "network": 20,
+        "port": 100,
+        "security_group": 10,
+        "security_group_rule": 100,
+        "subnet": 20
+    }
+}
+`
+
+var Quota = quotas.Quota{
+	Network:           10,
+	Port:              50,
+	SecurityGroup:     10,
+	SecurityGroupRule: 100,
+	Subnet:            20,
+}
+
+var QuotaDetail = quotas.QuotaDetailSet{
+	Network:           quotas.QuotaDetail{Used: 2, Reserved: 0, Limit: 10},
+	Port:              quotas.QuotaDetail{Used: 48, Reserved: 1, Limit: 50},
+	SecurityGroup:     quotas.QuotaDetail{Used: 1, Reserved: 0, Limit: 10},
+	SecurityGroupRule: quotas.QuotaDetail{Used: 4, Reserved: 0, Limit: 100},
+	Subnet:            quotas.QuotaDetail{Used: 2, Reserved: 0, Limit: -1},
+}
+
+var UpdatedQuota = quotas.Quota{
+	Network:           20,
+	Port:              100,
+	SecurityGroup:     10,
+	SecurityGroupRule: 100,
+	Subnet:            20,
+}
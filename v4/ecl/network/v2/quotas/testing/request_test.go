@@ -0,0 +1,88 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/quotas"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+func TestGetQuota(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/quotas/"+TenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetResponse)
+	})
+
+	q, err := quotas.Get(fake.ServiceClient(), TenantID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &Quota, q)
+}
+
+func TestGetQuotaDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/quotas/"+TenantID+"/details.json", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetDetailResponse)
+	})
+
+	q, err := quotas.GetDetail(fake.ServiceClient(), TenantID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &QuotaDetail, q)
+}
+
+func TestUpdateQuota(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/quotas/"+TenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, UpdateResponse)
+	})
+
+	updateOpts := quotas.UpdateOpts{
+		Network: eclcloud.IntToPointer(20),
+		Port:    eclcloud.IntToPointer(100),
+	}
+	q, err := quotas.Update(fake.ServiceClient(), TenantID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &UpdatedQuota, q)
+}
+
+func TestDeleteQuota(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/quotas/"+TenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := quotas.Delete(fake.ServiceClient(), TenantID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,25 @@
+package quotas
+
+import "github.com/nttcom/eclcloud/v4"
+
+const resourcePath = "quotas"
+
+func resourceURL(c *eclcloud.ServiceClient, tenantID string) string {
+	return c.ServiceURL(resourcePath, tenantID)
+}
+
+func getURL(c *eclcloud.ServiceClient, tenantID string) string {
+	return resourceURL(c, tenantID)
+}
+
+func getDetailURL(c *eclcloud.ServiceClient, tenantID string) string {
+	return c.ServiceURL(resourcePath, tenantID, "details.json")
+}
+
+func updateURL(c *eclcloud.ServiceClient, tenantID string) string {
+	return resourceURL(c, tenantID)
+}
+
+func deleteURL(c *eclcloud.ServiceClient, tenantID string) string {
+	return resourceURL(c, tenantID)
+}
@@ -0,0 +1,56 @@
+/*
+Package security_group_rules contains functionality for working with Neutron
+security group rule resources.
+
+A security group rule specifies the traffic which is permitted to pass
+through the ports that belong to its security group. Rules may only be
+created and deleted; to change a rule, delete it and create a new one.
+
+Example to List Security Group Rules
+
+	listOpts := security_group_rules.ListOpts{
+		Direction:       security_group_rules.DirIngress,
+		Protocol:        security_group_rules.ProtocolTCP,
+		SecurityGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5",
+	}
+
+	allPages, err := security_group_rules.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRules, err := security_group_rules.ExtractSecurityGroupRules(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, rule := range allRules {
+		fmt.Printf("%+v\n", rule)
+	}
+
+Example to Create a Security Group Rule
+
+	createOpts := security_group_rules.CreateOpts{
+		Direction:       security_group_rules.DirIngress,
+		EtherType:       security_group_rules.EtherType4,
+		PortRangeMin:    22,
+		PortRangeMax:    22,
+		Protocol:        security_group_rules.ProtocolTCP,
+		RemoteIPPrefix:  "0.0.0.0/0",
+		SecurityGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5",
+	}
+
+	rule, err := security_group_rules.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Security Group Rule
+
+	ruleID := "3c0e45ff-adaf-4124-b083-bf390e5482ff"
+	err := security_group_rules.Delete(networkClient, ruleID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package security_group_rules
@@ -0,0 +1,156 @@
+package security_group_rules
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// RuleDirection is the direction of traffic a rule applies to.
+type RuleDirection string
+
+// RuleProtocol is the IP protocol a rule matches.
+type RuleProtocol string
+
+// RuleEtherType is the layer 3 ether type a rule matches.
+type RuleEtherType string
+
+// Constants useful for CreateOpts and ListOpts.
+const (
+	DirIngress     RuleDirection = "ingress"
+	DirEgress      RuleDirection = "egress"
+	EtherType4     RuleEtherType = "IPv4"
+	EtherType6     RuleEtherType = "IPv6"
+	ProtocolAny    RuleProtocol  = ""
+	ProtocolICMP   RuleProtocol  = "icmp"
+	ProtocolTCP    RuleProtocol  = "tcp"
+	ProtocolUDP    RuleProtocol  = "udp"
+	ProtocolICMPv6 RuleProtocol  = "ipv6-icmp"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSecurityGroupRuleListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the security group rule attributes you want to see returned.
+type ListOpts struct {
+	Description     string        `q:"description"`
+	Direction       RuleDirection `q:"direction"`
+	EtherType       RuleEtherType `q:"ethertype"`
+	ID              string        `q:"id"`
+	PortRangeMax    int           `q:"port_range_max"`
+	PortRangeMin    int           `q:"port_range_min"`
+	Protocol        RuleProtocol  `q:"protocol"`
+	RemoteGroupID   string        `q:"remote_group_id"`
+	RemoteIPPrefix  string        `q:"remote_ip_prefix"`
+	SecurityGroupID string        `q:"security_group_id"`
+	TenantID        string        `q:"tenant_id"`
+}
+
+// ToSecurityGroupRuleListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSecurityGroupRuleListQuery() (string, error) {
+	q, err := eclcloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// security group rules. It accepts a ListOpts struct, which allows you to
+// filter the returned collection down to individual rules.
+func List(c *eclcloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToSecurityGroupRuleListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return SecurityGroupRulePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific security group rule based on its unique ID.
+func Get(c *eclcloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSecurityGroupRuleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents the attributes used when creating a new security
+// group rule.
+type CreateOpts struct {
+	// Description of the rule.
+	Description string `json:"description,omitempty"`
+
+	// Direction is the direction in which the rule is applied.
+	// Must be either "ingress" or "egress".
+	Direction RuleDirection `json:"direction" required:"true"`
+
+	// EtherType must be either "IPv4" or "IPv6", and the addresses represented
+	// in RemoteIPPrefix must match it.
+	EtherType RuleEtherType `json:"ethertype" required:"true"`
+
+	// PortRangeMax is the upper bound of the port range matched by the rule.
+	// When Protocol is "icmp" it is the ICMP code.
+	PortRangeMax int `json:"port_range_max,omitempty"`
+
+	// PortRangeMin is the lower bound of the port range matched by the rule.
+	// When Protocol is "icmp" it is the ICMP type.
+	PortRangeMin int `json:"port_range_min,omitempty"`
+
+	// Protocol matched by the rule. An empty value matches any protocol.
+	Protocol RuleProtocol `json:"protocol,omitempty"`
+
+	// RemoteGroupID is the remote security group ID to be associated with the
+	// rule. It is mutually exclusive with RemoteIPPrefix.
+	RemoteGroupID string `json:"remote_group_id,omitempty"`
+
+	// RemoteIPPrefix is the remote CIDR matched by the rule. It is mutually
+	// exclusive with RemoteGroupID.
+	RemoteIPPrefix string `json:"remote_ip_prefix,omitempty"`
+
+	// SecurityGroupID is the security group the rule belongs to.
+	SecurityGroupID string `json:"security_group_id" required:"true"`
+
+	// TenantID is the project owner of the rule.
+	TenantID string `json:"tenant_id,omitempty"`
+}
+
+// ToSecurityGroupRuleCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToSecurityGroupRuleCreateMap() (map[string]interface{}, error) {
+	if opts.RemoteGroupID != "" && opts.RemoteIPPrefix != "" {
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "security_group_rules.CreateOpts.RemoteGroupID/RemoteIPPrefix"
+		err.Info = "Only one of RemoteGroupID and RemoteIPPrefix may be provided"
+		return nil, err
+	}
+	return eclcloud.BuildRequestBody(opts, "security_group_rule")
+}
+
+// Create accepts a CreateOpts struct and creates a new security group rule
+// using the values provided.
+func Create(c *eclcloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSecurityGroupRuleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c), b, &r.Body, nil)
+	return
+}
+
+// Delete accepts a unique ID and deletes the security group rule associated
+// with it.
+func Delete(c *eclcloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return
+}
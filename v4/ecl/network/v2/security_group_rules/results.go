@@ -0,0 +1,115 @@
+package security_group_rules
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+type commonResult struct {
+	eclcloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a security group
+// rule resource.
+func (r commonResult) Extract() (*SecurityGroupRule, error) {
+	var s SecurityGroupRule
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "security_group_rule")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a SecurityGroupRule.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a SecurityGroupRule.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	eclcloud.ErrResult
+}
+
+// SecurityGroupRule represents a single rule of a security group.
+type SecurityGroupRule struct {
+	// Description of the rule.
+	Description string `json:"description"`
+
+	// Direction is either "ingress" or "egress".
+	Direction string `json:"direction"`
+
+	// EtherType is either "IPv4" or "IPv6".
+	EtherType string `json:"ethertype"`
+
+	// UUID for the security group rule.
+	ID string `json:"id"`
+
+	// PortRangeMax is the upper bound of the matched port range.
+	PortRangeMax int `json:"port_range_max"`
+
+	// PortRangeMin is the lower bound of the matched port range.
+	PortRangeMin int `json:"port_range_min"`
+
+	// Protocol matched by the rule. Empty means any protocol.
+	Protocol string `json:"protocol"`
+
+	// RemoteGroupID is the remote security group associated with the rule.
+	RemoteGroupID string `json:"remote_group_id"`
+
+	// RemoteIPPrefix is the remote CIDR matched by the rule.
+	RemoteIPPrefix string `json:"remote_ip_prefix"`
+
+	// SecurityGroupID is the security group the rule belongs to.
+	SecurityGroupID string `json:"security_group_id"`
+
+	// TenantID is the project owner of the rule.
+	TenantID string `json:"tenant_id"`
+}
+
+// SecurityGroupRulePage is the page returned by a pager when traversing over
+// a collection of security group rules.
+type SecurityGroupRulePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of security group rules
+// has reached the end of a page and the pager seeks to traverse over a new
+// one. In order to do this, it needs to construct the next page's URL.
+func (r SecurityGroupRulePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []eclcloud.Link `json:"security_group_rules_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return eclcloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a SecurityGroupRulePage struct is empty.
+func (r SecurityGroupRulePage) IsEmpty() (bool, error) {
+	is, err := ExtractSecurityGroupRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractSecurityGroupRules accepts a Page struct, specifically a
+// SecurityGroupRulePage struct, and extracts the elements into a slice of
+// SecurityGroupRule structs.
+func ExtractSecurityGroupRules(r pagination.Page) ([]SecurityGroupRule, error) {
+	var s []SecurityGroupRule
+	err := ExtractSecurityGroupRulesInto(r, &s)
+	return s, err
+}
+
+func ExtractSecurityGroupRulesInto(r pagination.Page, v interface{}) error {
+	return r.(SecurityGroupRulePage).Result.ExtractIntoSlicePtr(v, "security_group_rules")
+}
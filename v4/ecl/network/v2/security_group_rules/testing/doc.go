@@ -0,0 +1,2 @@
+// security_group_rules unit tests
+package testing
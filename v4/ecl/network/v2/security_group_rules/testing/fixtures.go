@@ -0,0 +1,93 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/security_group_rules"
+)
+
+const ListResponse = `
+{
+	"security_group_rules": [
+	  {
+		"description": "",
+		"direction": "egress",
+		"ethertype": "IPv4",
+		"id": "93aa42e5-80db-4581-9391-3a608bd0e448",
+		"port_range_max": null,
+		"port_range_min": null,
+		"protocol": null,
+		"remote_group_id": null,
+		"remote_ip_prefix": null,
+		"security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+		"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	  },
+	  {
+		"description": "ssh",
+		"direction": "ingress",
+		"ethertype": "IPv4",
+		"id": "3c0e45ff-adaf-4124-b083-bf390e5482ff",
+		"port_range_max": 22,
+		"port_range_min": 22,
+		"protocol": "tcp",
+		"remote_group_id": null,
+		"remote_ip_prefix": "0.0.0.0/0",
+		"security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+		"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	  }
+	]
+  }`
+
+const GetResponse = `
+{
+	"security_group_rule": {
+	  "description": "ssh",
+	  "direction": "ingress",
+	  "ethertype": "IPv4",
+	  "id": "3c0e45ff-adaf-4124-b083-bf390e5482ff",
+	  "port_range_max": 22,
+	  "port_range_min": 22,
+	  "protocol": "tcp",
+	  "remote_group_id": null,
+	  "remote_ip_prefix": "0.0.0.0/0",
+	  "security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+	  "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	}
+  }`
+
+const CreateRequest = `
+{
+	"security_group_rule": {
+	  "description": "ssh",
+	  "direction": "ingress",
+	  "ethertype": "IPv4",
+	  "port_range_max": 22,
+	  "port_range_min": 22,
+	  "protocol": "tcp",
+	  "remote_ip_prefix": "0.0.0.0/0",
+	  "security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5"
+	}
+  }`
+
+const CreateResponse = GetResponse
+
+var Rule1 = security_group_rules.SecurityGroupRule{
+	Direction:       "egress",
+	EtherType:       "IPv4",
+	ID:              "93aa42e5-80db-4581-9391-3a608bd0e448",
+	SecurityGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5",
+	TenantID:        "dcb2d589c0c646d0bad45c0cf9f90cf1",
+}
+
+var Rule2 = security_group_rules.SecurityGroupRule{
+	Description:     "ssh",
+	Direction:       "ingress",
+	EtherType:       "IPv4",
+	ID:              "3c0e45ff-adaf-4124-b083-bf390e5482ff",
+	PortRangeMax:    22,
+	PortRangeMin:    22,
+	Protocol:        "tcp",
+	RemoteIPPrefix:  "0.0.0.0/0",
+	SecurityGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5",
+	TenantID:        "dcb2d589c0c646d0bad45c0cf9f90cf1",
+}
+
+var ExpectedRuleSlice = []security_group_rules.SecurityGroupRule{Rule1, Rule2}
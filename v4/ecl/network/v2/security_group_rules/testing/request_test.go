@@ -0,0 +1,167 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/security_group_rules"
+	"github.com/nttcom/eclcloud/v4/pagination"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+func TestListSecurityGroupRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+
+	client := fake.ServiceClient()
+	count := 0
+
+	security_group_rules.List(client, security_group_rules.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := security_group_rules.ExtractSecurityGroupRules(page)
+		if err != nil {
+			t.Errorf("Failed to extract security group rules: %v", err)
+			return false, nil
+		}
+
+		th.CheckDeepEquals(t, ExpectedRuleSlice, actual)
+
+		return true, nil
+	})
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestListSecurityGroupRuleFiltered(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"direction":         "ingress",
+			"protocol":          "tcp",
+			"port_range_min":    "22",
+			"security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `{"security_group_rules": []}`)
+	})
+
+	listOpts := security_group_rules.ListOpts{
+		Direction:       security_group_rules.DirIngress,
+		Protocol:        security_group_rules.ProtocolTCP,
+		PortRangeMin:    22,
+		SecurityGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5",
+	}
+	allPages, err := security_group_rules.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := security_group_rules.ExtractSecurityGroupRules(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, len(actual))
+}
+
+func TestGetSecurityGroupRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules/3c0e45ff-adaf-4124-b083-bf390e5482ff", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetResponse)
+	})
+
+	r, err := security_group_rules.Get(fake.ServiceClient(), "3c0e45ff-adaf-4124-b083-bf390e5482ff").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &Rule2, r)
+}
+
+func TestCreateSecurityGroupRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, CreateResponse)
+	})
+
+	options := security_group_rules.CreateOpts{
+		Description:     "ssh",
+		Direction:       security_group_rules.DirIngress,
+		EtherType:       security_group_rules.EtherType4,
+		PortRangeMax:    22,
+		PortRangeMin:    22,
+		Protocol:        security_group_rules.ProtocolTCP,
+		RemoteIPPrefix:  "0.0.0.0/0",
+		SecurityGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5",
+	}
+	r, err := security_group_rules.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &Rule2, r)
+}
+
+func TestRequiredCreateOptsSecurityGroupRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	res := security_group_rules.Create(fake.ServiceClient(), security_group_rules.CreateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+
+	res = security_group_rules.Create(fake.ServiceClient(), security_group_rules.CreateOpts{
+		Direction:       security_group_rules.DirIngress,
+		EtherType:       security_group_rules.EtherType4,
+		RemoteGroupID:   "85cc3048-abc3-43cc-89b3-377341426ac5",
+		RemoteIPPrefix:  "0.0.0.0/0",
+		SecurityGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5",
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestDeleteSecurityGroupRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules/3c0e45ff-adaf-4124-b083-bf390e5482ff", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := security_group_rules.Delete(fake.ServiceClient(), "3c0e45ff-adaf-4124-b083-bf390e5482ff")
+	th.AssertNoErr(t, res.Err)
+}
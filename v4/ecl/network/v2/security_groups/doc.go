@@ -0,0 +1,65 @@
+/*
+Package security_groups contains functionality for working with Neutron
+security group resources.
+
+A security group is a named container for security group rules, which
+specify the types of network traffic permitted to pass through the ports
+associated with the group. Ports are associated with security groups through
+the SecurityGroups attribute of ports.CreateOpts and ports.UpdateOpts, and
+servers may be placed into groups at boot through servers.CreateOpts.
+
+Example to List Security Groups
+
+	listOpts := security_groups.ListOpts{
+		TenantID: "966b3c7d36a24facaf20b7e458bf2192",
+	}
+
+	allPages, err := security_groups.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allGroups, err := security_groups.ExtractSecurityGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, group := range allGroups {
+		fmt.Printf("%+v\n", group)
+	}
+
+Example to Create a Security Group
+
+	createOpts := security_groups.CreateOpts{
+		Name:        "web",
+		Description: "allow HTTP and HTTPS",
+	}
+
+	group, err := security_groups.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Security Group
+
+	groupID := "85cc3048-abc3-43cc-89b3-377341426ac5"
+	name := "new_name"
+
+	updateOpts := security_groups.UpdateOpts{
+		Name: &name,
+	}
+
+	group, err := security_groups.Update(networkClient, groupID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Security Group
+
+	groupID := "85cc3048-abc3-43cc-89b3-377341426ac5"
+	err := security_groups.Delete(networkClient, groupID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package security_groups
@@ -0,0 +1,160 @@
+package security_groups
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSecurityGroupListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the security group attributes you want to see returned.
+type ListOpts struct {
+	Description string `q:"description"`
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	TenantID    string `q:"tenant_id"`
+}
+
+// ToSecurityGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSecurityGroupListQuery() (string, error) {
+	q, err := eclcloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// security groups. It accepts a ListOpts struct, which allows you to filter
+// the returned collection for greater efficiency.
+func List(c *eclcloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToSecurityGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return SecurityGroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific security group based on its unique ID.
+func Get(c *eclcloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSecurityGroupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents the attributes used when creating a new security
+// group.
+type CreateOpts struct {
+	Description string            `json:"description,omitempty"`
+	Name        string            `json:"name" required:"true"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	TenantID    string            `json:"tenant_id,omitempty"`
+}
+
+// ToSecurityGroupCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToSecurityGroupCreateMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "security_group")
+}
+
+// Create accepts a CreateOpts struct and creates a new security group using
+// the values provided. The API populates the group with default egress rules.
+func Create(c *eclcloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSecurityGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c), b, &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToSecurityGroupUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents the attributes used when updating an existing
+// security group.
+type UpdateOpts struct {
+	Description *string            `json:"description,omitempty"`
+	Name        *string            `json:"name,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty"`
+}
+
+// ToSecurityGroupUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToSecurityGroupUpdateMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "security_group")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing security group
+// using the values provided.
+func Update(c *eclcloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToSecurityGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(updateURL(c, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// Delete accepts a unique ID and deletes the security group associated with
+// it.
+func Delete(c *eclcloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return
+}
+
+// IDFromName is a convenience function that returns a security group's ID,
+// given its name.
+func IDFromName(client *eclcloud.ServiceClient, name string) (string, error) {
+	count := 0
+	id := ""
+
+	listOpts := ListOpts{
+		Name: name,
+	}
+
+	pages, err := List(client, listOpts).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractSecurityGroups(pages)
+	if err != nil {
+		return "", err
+	}
+
+	for _, s := range all {
+		if s.Name == name {
+			count++
+			id = s.ID
+		}
+	}
+
+	switch count {
+	case 0:
+		return "", eclcloud.ErrResourceNotFound{Name: name, ResourceType: "security_group"}
+	case 1:
+		return id, nil
+	default:
+		return "", eclcloud.ErrMultipleResourcesFound{Name: name, Count: count, ResourceType: "security_group"}
+	}
+}
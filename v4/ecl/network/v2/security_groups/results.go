@@ -0,0 +1,107 @@
+package security_groups
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/security_group_rules"
+	"github.com/nttcom/eclcloud/v4/pagination"
+)
+
+type commonResult struct {
+	eclcloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a security group
+// resource.
+func (r commonResult) Extract() (*SecurityGroup, error) {
+	var s SecurityGroup
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "security_group")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a SecurityGroup.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a SecurityGroup.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a SecurityGroup.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	eclcloud.ErrResult
+}
+
+// SecurityGroup represents a container for security group rules.
+type SecurityGroup struct {
+	// Description of the security group.
+	Description string `json:"description"`
+
+	// UUID for the security group.
+	ID string `json:"id"`
+
+	// Human-readable name for the security group. Might not be unique.
+	Name string `json:"name"`
+
+	// Rules is the list of rules which belong to the security group.
+	Rules []security_group_rules.SecurityGroupRule `json:"security_group_rules"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags map[string]string `json:"tags"`
+
+	// TenantID is the project owner of the security group.
+	TenantID string `json:"tenant_id"`
+}
+
+// SecurityGroupPage is the page returned by a pager when traversing over a
+// collection of security groups.
+type SecurityGroupPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of security groups has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r SecurityGroupPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []eclcloud.Link `json:"security_groups_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return eclcloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a SecurityGroupPage struct is empty.
+func (r SecurityGroupPage) IsEmpty() (bool, error) {
+	is, err := ExtractSecurityGroups(r)
+	return len(is) == 0, err
+}
+
+// ExtractSecurityGroups accepts a Page struct, specifically a
+// SecurityGroupPage struct, and extracts the elements into a slice of
+// SecurityGroup structs.
+func ExtractSecurityGroups(r pagination.Page) ([]SecurityGroup, error) {
+	var s []SecurityGroup
+	err := ExtractSecurityGroupsInto(r, &s)
+	return s, err
+}
+
+func ExtractSecurityGroupsInto(r pagination.Page, v interface{}) error {
+	return r.(SecurityGroupPage).Result.ExtractIntoSlicePtr(v, "security_groups")
+}
@@ -0,0 +1,2 @@
+// security_groups unit tests
+package testing
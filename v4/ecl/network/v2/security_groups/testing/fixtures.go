@@ -0,0 +1,123 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/security_group_rules"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/security_groups"
+)
+
+const ListResponse = `
+{
+	"security_groups": [
+	  {
+		"description": "default",
+		"id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+		"name": "default",
+		"security_group_rules": [
+		  {
+			"description": "",
+			"direction": "egress",
+			"ethertype": "IPv4",
+			"id": "93aa42e5-80db-4581-9391-3a608bd0e448",
+			"port_range_max": null,
+			"port_range_min": null,
+			"protocol": null,
+			"remote_group_id": null,
+			"remote_ip_prefix": null,
+			"security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+			"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+		  }
+		],
+		"tags": {},
+		"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	  },
+	  {
+		"description": "allow HTTP and HTTPS",
+		"id": "2076db17-a522-4506-91de-c6dd8e837028",
+		"name": "web",
+		"security_group_rules": [],
+		"tags": {
+		  "role": "web"
+		},
+		"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	  }
+	]
+  }`
+
+const GetResponse = `
+{
+	"security_group": {
+	  "description": "allow HTTP and HTTPS",
+	  "id": "2076db17-a522-4506-91de-c6dd8e837028",
+	  "name": "web",
+	  "security_group_rules": [],
+	  "tags": {
+		"role": "web"
+	  },
+	  "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	}
+  }`
+
+const CreateRequest = `
+{
+	"security_group": {
+	  "description": "allow HTTP and HTTPS",
+	  "name": "web",
+	  "tags": {
+		"role": "web"
+	  }
+	}
+  }`
+
+const CreateResponse = GetResponse
+
+const UpdateRequest = `
+{
+	"security_group": {
+	  "description": "UPDATED",
+	  "name": "UPDATED"
+	}
+  }`
+
+const UpdateResponse = `
+{
+	"security_group": {
+	  "description": "UPDATED",
+	  "id": "2076db17-a522-4506-91de-c6dd8e837028",
+	  "name": "UPDATED",
+	  "security_group_rules": [],
+	  "tags": {
+		"role": "web"
+	  },
+	  "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+	}
+  }`
+
+var SecurityGroup1 = security_groups.SecurityGroup{
+	Description: "default",
+	ID:          "85cc3048-abc3-43cc-89b3-377341426ac5",
+	Name:        "default",
+	Rules: []security_group_rules.SecurityGroupRule{
+		{
+			Direction:       "egress",
+			EtherType:       "IPv4",
+			ID:              "93aa42e5-80db-4581-9391-3a608bd0e448",
+			SecurityGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5",
+			TenantID:        "dcb2d589c0c646d0bad45c0cf9f90cf1",
+		},
+	},
+	Tags:     map[string]string{},
+	TenantID: "dcb2d589c0c646d0bad45c0cf9f90cf1",
+}
+
+var SecurityGroup2 = security_groups.SecurityGroup{
+	Description: "allow HTTP and HTTPS",
+	ID:          "2076db17-a522-4506-91de-c6dd8e837028",
+	Name:        "web",
+	Rules:       []security_group_rules.SecurityGroupRule{},
+	Tags: map[string]string{
+		"role": "web",
+	},
+	TenantID: "dcb2d589c0c646d0bad45c0cf9f90cf1",
+}
+
+var ExpectedSecurityGroupSlice = []security_groups.SecurityGroup{SecurityGroup1, SecurityGroup2}
@@ -2,6 +2,7 @@ package subnets
 
 import (
 	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/tags/selector"
 	"github.com/nttcom/eclcloud/v4/pagination"
 )
 
@@ -28,6 +29,21 @@ type ListOpts struct {
 	NetworkID       string `q:"network_id"`
 	Status          string `q:"status"`
 	TenantID        string `q:"tenant_id"`
+
+	// Tags is a tag selector expression such as "env=prod,team!=ops". See
+	// package tags for how the results are filtered.
+	Tags string
+}
+
+// ListTagsBuilder is implemented by a ListOptsBuilder which also filters the
+// results by tags.
+type ListTagsBuilder interface {
+	ToSubnetListTags() (selector.Selector, error)
+}
+
+// ToSubnetListTags parses the Tags of a ListOpts.
+func (opts ListOpts) ToSubnetListTags() (selector.Selector, error) {
+	return selector.Parse(opts.Tags)
 }
 
 // ToSubnetListQuery formats a ListOpts into a query string.
@@ -45,15 +61,25 @@ func (opts ListOpts) ToSubnetListQuery() (string, error) {
 // administrative rights.
 func List(c *eclcloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	url := listURL(c)
+	var tags selector.Selector
 	if opts != nil {
 		query, err := opts.ToSubnetListQuery()
 		if err != nil {
 			return pagination.Pager{Err: err}
 		}
 		url += query
+
+		if b, ok := opts.(ListTagsBuilder); ok {
+			tags, err = b.ToSubnetListTags()
+			if err != nil {
+				return pagination.Pager{Err: err}
+			}
+		}
 	}
 	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
-		return SubnetPage{pagination.LinkedPageBase{PageResult: r}}
+		var filtered bool
+		r.Body, filtered = tags.FilterBody(r.Body, "subnets")
+		return SubnetPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}, filtered: filtered}
 	})
 }
 
@@ -118,6 +118,9 @@ type Subnet struct {
 // of subnets.
 type SubnetPage struct {
 	pagination.LinkedPageBase
+
+	// filtered is set when tags removed subnets from the page.
+	filtered bool
 }
 
 // NextPageURL is invoked when a paginated collection of subnets has reached
@@ -136,6 +139,9 @@ func (r SubnetPage) NextPageURL() (string, error) {
 
 // IsEmpty checks whether a SubnetPage struct is empty.
 func (r SubnetPage) IsEmpty() (bool, error) {
+	if r.filtered {
+		return false, nil
+	}
 	is, err := ExtractSubnets(r)
 	return len(is) == 0, err
 }
@@ -0,0 +1,42 @@
+/*
+Package tags finds network resources by their tags.
+
+Networks, subnets and ports carry key/value tags. Their ListOpts accept a
+tag selector expression in the Tags field, and FindByTags searches all of
+them at once. Selector expressions are described in package selector.
+
+The API cannot filter by tags, so List retrieves every page and drops the
+resources whose tags do not match as each page arrives. A page may therefore
+hold fewer resources than requested, or none at all, and paging continues
+until the API has no more pages. Custom list options can filter by tags too
+by implementing the ListTagsBuilder interface of the networks, subnets or
+ports package.
+
+Example to List Networks by Tags
+
+	listOpts := networks.ListOpts{
+		Tags: "env=prod,team!=ops",
+	}
+
+	allPages, err := networks.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allNetworks, err := networks.ExtractNetworks(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Find All Resources Matching a Selector
+
+	found, err := tags.FindByTags(networkClient, "env=prod,!deprecated")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, port := range found.Ports {
+		fmt.Printf("%s %+v\n", port.ID, port.Tags)
+	}
+*/
+package tags
@@ -0,0 +1,52 @@
+package tags
+
+import (
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/networks"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/subnets"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/tags/selector"
+)
+
+// Resources holds the network resources found by FindByTags.
+type Resources struct {
+	Networks []networks.Network
+	Subnets  []subnets.Subnet
+	Ports    []ports.Port
+}
+
+// FindByTags returns the networks, subnets and ports whose tags match the
+// given selector expression, such as "env=prod,team!=ops".
+func FindByTags(c *eclcloud.ServiceClient, expr string) (*Resources, error) {
+	if _, err := selector.Parse(expr); err != nil {
+		return nil, err
+	}
+
+	var res Resources
+
+	pages, err := networks.List(c, networks.ListOpts{Tags: expr}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.Networks, err = networks.ExtractNetworks(pages); err != nil {
+		return nil, err
+	}
+
+	pages, err = subnets.List(c, subnets.ListOpts{Tags: expr}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.Subnets, err = subnets.ExtractSubnets(pages); err != nil {
+		return nil, err
+	}
+
+	pages, err = ports.List(c, ports.ListOpts{Tags: expr}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.Ports, err = ports.ExtractPorts(pages); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
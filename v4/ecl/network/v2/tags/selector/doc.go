@@ -0,0 +1,11 @@
+// Package selector parses and evaluates tag selector expressions such as
+// "env=prod,team!=ops" against the key/value tags of network resources.
+//
+// An expression is a comma separated list of requirements, all of which must
+// hold:
+//
+//	key=value   the tag is present and has the value ("==" is also accepted)
+//	key!=value  the tag is absent or has a different value
+//	key         the tag is present
+//	!key        the tag is absent
+package selector
@@ -0,0 +1,168 @@
+package selector
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// Operator is the comparison performed by a Requirement.
+type Operator string
+
+const (
+	Equals       Operator = "="
+	NotEquals    Operator = "!="
+	Exists       Operator = "exists"
+	DoesNotExist Operator = "!"
+)
+
+// Requirement is a single condition of a Selector.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Value    string
+}
+
+// Matches reports whether tags satisfy the requirement.
+func (r Requirement) Matches(tags map[string]string) bool {
+	value, ok := tags[r.Key]
+	switch r.Operator {
+	case Equals:
+		return ok && value == r.Value
+	case NotEquals:
+		return !ok || value != r.Value
+	case Exists:
+		return ok
+	case DoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+func (r Requirement) String() string {
+	switch r.Operator {
+	case Exists:
+		return r.Key
+	case DoesNotExist:
+		return "!" + r.Key
+	}
+	return r.Key + string(r.Operator) + r.Value
+}
+
+// Selector is a set of requirements which tags must all satisfy. An empty
+// Selector matches any tags.
+type Selector []Requirement
+
+// Parse parses a selector expression. It returns an ErrInvalidInput if the
+// expression is malformed.
+func Parse(expr string) (Selector, error) {
+	var sel Selector
+	if strings.TrimSpace(expr) == "" {
+		return sel, nil
+	}
+
+	for _, term := range strings.Split(expr, ",") {
+		term = strings.TrimSpace(term)
+
+		var r Requirement
+		switch {
+		case strings.Contains(term, "!="):
+			parts := strings.SplitN(term, "!=", 2)
+			r = Requirement{Key: parts[0], Operator: NotEquals, Value: parts[1]}
+		case strings.Contains(term, "=="):
+			parts := strings.SplitN(term, "==", 2)
+			r = Requirement{Key: parts[0], Operator: Equals, Value: parts[1]}
+		case strings.Contains(term, "="):
+			parts := strings.SplitN(term, "=", 2)
+			r = Requirement{Key: parts[0], Operator: Equals, Value: parts[1]}
+		case strings.HasPrefix(term, "!"):
+			r = Requirement{Key: term[1:], Operator: DoesNotExist}
+		default:
+			r = Requirement{Key: term, Operator: Exists}
+		}
+		r.Key = strings.TrimSpace(r.Key)
+		r.Value = strings.TrimSpace(r.Value)
+
+		if r.Key == "" || strings.ContainsAny(r.Key, "!=") || strings.ContainsAny(r.Value, "!=") {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "selector"
+			err.Value = expr
+			err.Info = fmt.Sprintf("Invalid tag selector term [%s] in [%s]", term, expr)
+			return nil, err
+		}
+
+		sel = append(sel, r)
+	}
+
+	return sel, nil
+}
+
+// Matches reports whether tags satisfy every requirement of the selector.
+func (s Selector) Matches(tags map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(tags) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s Selector) String() string {
+	terms := make([]string, len(s))
+	for i, r := range s {
+		terms[i] = r.String()
+	}
+	return strings.Join(terms, ",")
+}
+
+// FilterBody removes the resources whose tags do not match the selector
+// from the list stored under key in a parsed JSON response body. It returns
+// the filtered body, which shares no list with the original, and whether any
+// resource was removed. Bodies of any other shape are returned unchanged.
+func (s Selector) FilterBody(body interface{}, key string) (interface{}, bool) {
+	if len(s) == 0 {
+		return body, false
+	}
+
+	m, ok := body.(map[string]interface{})
+	if !ok {
+		return body, false
+	}
+	list, ok := m[key].([]interface{})
+	if !ok {
+		return body, false
+	}
+
+	kept := make([]interface{}, 0, len(list))
+	for _, item := range list {
+		resource, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if s.Matches(tagsOf(resource)) {
+			kept = append(kept, item)
+		}
+	}
+
+	filtered := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		filtered[k] = v
+	}
+	filtered[key] = kept
+
+	return filtered, len(kept) < len(list)
+}
+
+func tagsOf(resource map[string]interface{}) map[string]string {
+	raw, _ := resource["tags"].(map[string]interface{})
+	tags := make(map[string]string, len(raw))
+	for k, v := range raw {
+		if s, ok := v.(string); ok {
+			tags[k] = s
+		} else {
+			tags[k] = fmt.Sprint(v)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,84 @@
+package testing
+
+const NetworkListResponse = `
+{
+  "networks": [
+    {
+      "id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+      "name": "prod-network",
+      "status": "ACTIVE",
+      "tags": {"env": "prod", "team": "web"}
+    },
+    {
+      "id": "a033d04b-b1fe-4ff4-a7c7-5f4b6da981d2",
+      "name": "ops-network",
+      "status": "ACTIVE",
+      "tags": {"env": "prod", "team": "ops"}
+    }
+  ]
+}
+`
+
+const SubnetListResponse = `
+{
+  "subnets": [
+    {
+      "cidr": "192.168.2.0/24",
+      "id": "ab49eb24-667f-4a4e-9421-b4d915bff416",
+      "name": "dev-subnet",
+      "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+      "tags": {"env": "dev"}
+    }
+  ]
+}
+`
+
+const PortListResponse = `
+{
+  "ports": [
+    {
+      "id": "5f5b4e14-39c2-4b63-9f60-3e7e5ee2a4b0",
+      "name": "untagged-port",
+      "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+      "tags": {}
+    },
+    {
+      "id": "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730",
+      "name": "prod-port",
+      "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+      "tags": {"env": "prod"}
+    }
+  ]
+}
+`
+
+// NetworkListFirstPage has no matching networks, but links to a second page
+// which does.
+const NetworkListFirstPage = `
+{
+  "networks": [
+    {
+      "id": "a033d04b-b1fe-4ff4-a7c7-5f4b6da981d2",
+      "name": "ops-network",
+      "status": "ACTIVE",
+      "tags": {"env": "prod", "team": "ops"}
+    }
+  ],
+  "networks_links": [
+    {"href": "%s/v2.0/networks?marker=a033d04b-b1fe-4ff4-a7c7-5f4b6da981d2", "rel": "next"}
+  ]
+}
+`
+
+const NetworkListSecondPage = `
+{
+  "networks": [
+    {
+      "id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+      "name": "prod-network",
+      "status": "ACTIVE",
+      "tags": {"env": "prod", "team": "web"}
+    }
+  ]
+}
+`
@@ -0,0 +1,165 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/networks"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/tags"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/tags/selector"
+	"github.com/nttcom/eclcloud/v4/pagination"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+func TestSelectorMatches(t *testing.T) {
+	tags := map[string]string{"env": "prod", "team": "web"}
+
+	tests := []struct {
+		expr    string
+		matches bool
+	}{
+		{"", true},
+		{"env=prod", true},
+		{"env==prod", true},
+		{"env=dev", false},
+		{"env=prod,team!=ops", true},
+		{"env=prod,team!=web", false},
+		{"owner!=alice", true},
+		{"team", true},
+		{"owner", false},
+		{"!owner", true},
+		{"!team", false},
+		{" env = prod , team ", true},
+	}
+
+	for _, test := range tests {
+		sel, err := selector.Parse(test.expr)
+		th.AssertNoErr(t, err)
+		if sel.Matches(tags) != test.matches {
+			t.Errorf("Expected %q to match %v: %v", test.expr, tags, test.matches)
+		}
+	}
+}
+
+func TestSelectorParseInvalid(t *testing.T) {
+	for _, expr := range []string{"=prod", "env=prod,", "!", "env=a=b", "env!=a!=b"} {
+		_, err := selector.Parse(expr)
+		if _, ok := err.(eclcloud.ErrInvalidInput); !ok {
+			t.Errorf("Expected ErrInvalidInput for %q, got %#v", expr, err)
+		}
+	}
+}
+
+func TestSelectorString(t *testing.T) {
+	sel, err := selector.Parse("env=prod, team!=ops,owner,!deprecated")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "env=prod,team!=ops,owner,!deprecated", sel.String())
+}
+
+func handleList(t *testing.T, path, response string) {
+	th.Mux.HandleFunc("/v2.0/"+path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, response)
+	})
+}
+
+func TestListNetworksByTags(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleList(t, "networks", NetworkListResponse)
+
+	allPages, err := networks.List(fake.ServiceClient(), networks.ListOpts{Tags: "env=prod,team!=ops"}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := networks.ExtractNetworks(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "prod-network", actual[0].Name)
+}
+
+func TestListNetworksByTagsPointer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleList(t, "networks", NetworkListResponse)
+
+	allPages, err := networks.List(fake.ServiceClient(), &networks.ListOpts{Tags: "env=prod,team!=ops"}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := networks.ExtractNetworks(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "prod-network", actual[0].Name)
+}
+
+func TestListNetworksByTagsInvalid(t *testing.T) {
+	err := networks.List(fake.ServiceClient(), networks.ListOpts{Tags: "=prod"}).EachPage(func(pagination.Page) (bool, error) {
+		return true, nil
+	})
+	if _, ok := err.(eclcloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput, got %#v", err)
+	}
+}
+
+func TestListNetworksByTagsPaginated(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if r.URL.Query().Get("marker") == "" {
+			fmt.Fprintf(w, NetworkListFirstPage, th.Server.URL)
+			return
+		}
+		fmt.Fprintf(w, NetworkListSecondPage)
+	})
+
+	pages := 0
+	var names []string
+	err := networks.List(fake.ServiceClient(), networks.ListOpts{Tags: "team=web"}).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+		actual, err := networks.ExtractNetworks(page)
+		if err != nil {
+			return false, err
+		}
+		for _, n := range actual {
+			names = append(names, n.Name)
+		}
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, pages)
+	th.CheckDeepEquals(t, []string{"prod-network"}, names)
+}
+
+func TestFindByTags(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleList(t, "networks", NetworkListResponse)
+	handleList(t, "subnets", SubnetListResponse)
+	handleList(t, "ports", PortListResponse)
+
+	found, err := tags.FindByTags(fake.ServiceClient(), "env=prod,team!=ops")
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(found.Networks))
+	th.AssertEquals(t, "8f36b88a-443f-4d97-9751-34d34af9e782", found.Networks[0].ID)
+	th.AssertEquals(t, 0, len(found.Subnets))
+	th.AssertEquals(t, 1, len(found.Ports))
+	th.AssertEquals(t, "ac57c5c9-aaf4-4ffc-b8b8-f1ef84656730", found.Ports[0].ID)
+}
@@ -0,0 +1,378 @@
+package topology
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/managed_load_balancer/v1/load_balancers"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/common_function_gateways"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/fic_gateways"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/gateway_interfaces"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/internet_gateways"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/networks"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/public_ips"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/static_routes"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/subnets"
+	"github.com/nttcom/eclcloud/v4/ecl/vna/v1/appliances"
+)
+
+// Clients holds the service clients used by Build. Network is required; the
+// VNA and ManagedLoadBalancer clients are optional, and the corresponding
+// resources are left out of the graph when they are nil.
+type Clients struct {
+	Network             *eclcloud.ServiceClient
+	VNA                 *eclcloud.ServiceClient
+	ManagedLoadBalancer *eclcloud.ServiceClient
+}
+
+// BuildOpts controls which resources Build retrieves.
+type BuildOpts struct {
+	// TenantID restricts the graph to resources owned by the given tenant.
+	TenantID string
+}
+
+// Resources holds the resources a Graph is built from.
+type Resources struct {
+	Networks               []networks.Network
+	Subnets                []subnets.Subnet
+	Ports                  []ports.Port
+	InternetGateways       []internet_gateways.InternetGateway
+	GatewayInterfaces      []gateway_interfaces.GatewayInterface
+	PublicIPs              []public_ips.PublicIP
+	StaticRoutes           []static_routes.StaticRoute
+	CommonFunctionGateways []common_function_gateways.CommonFunctionGateway
+	FICGateways            []fic_gateways.FICGateway
+	Appliances             []appliances.Appliance
+	LoadBalancers          []load_balancers.LoadBalancer
+}
+
+// Build retrieves the network resources visible through the given clients
+// and returns the graph of how they connect.
+func Build(c Clients, opts BuildOpts) (*Graph, error) {
+	res, err := Fetch(c, opts)
+	if err != nil {
+		return nil, err
+	}
+	return NewGraph(*res), nil
+}
+
+// Fetch retrieves the network resources visible through the given clients.
+func Fetch(c Clients, opts BuildOpts) (*Resources, error) {
+	if c.Network == nil {
+		err := eclcloud.ErrMissingInput{}
+		err.Argument = "topology.Clients.Network"
+		return nil, err
+	}
+
+	var res Resources
+	tenantID := opts.TenantID
+
+	pages, err := networks.List(c.Network, networks.ListOpts{TenantID: tenantID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.Networks, err = networks.ExtractNetworks(pages); err != nil {
+		return nil, err
+	}
+
+	pages, err = subnets.List(c.Network, subnets.ListOpts{TenantID: tenantID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.Subnets, err = subnets.ExtractSubnets(pages); err != nil {
+		return nil, err
+	}
+
+	pages, err = ports.List(c.Network, ports.ListOpts{TenantID: tenantID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.Ports, err = ports.ExtractPorts(pages); err != nil {
+		return nil, err
+	}
+
+	pages, err = internet_gateways.List(c.Network, internet_gateways.ListOpts{TenantID: tenantID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.InternetGateways, err = internet_gateways.ExtractInternetGateways(pages); err != nil {
+		return nil, err
+	}
+
+	pages, err = gateway_interfaces.List(c.Network, gateway_interfaces.ListOpts{TenantID: tenantID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.GatewayInterfaces, err = gateway_interfaces.ExtractGatewayInterfaces(pages); err != nil {
+		return nil, err
+	}
+
+	pages, err = public_ips.List(c.Network, public_ips.ListOpts{TenantID: tenantID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.PublicIPs, err = public_ips.ExtractPublicIPs(pages); err != nil {
+		return nil, err
+	}
+
+	pages, err = static_routes.List(c.Network, static_routes.ListOpts{TenantID: tenantID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.StaticRoutes, err = static_routes.ExtractStaticRoutes(pages); err != nil {
+		return nil, err
+	}
+
+	pages, err = common_function_gateways.List(c.Network, common_function_gateways.ListOpts{TenantID: tenantID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.CommonFunctionGateways, err = common_function_gateways.ExtractCommonFunctionGateways(pages); err != nil {
+		return nil, err
+	}
+
+	pages, err = fic_gateways.List(c.Network, fic_gateways.ListOpts{TenantID: tenantID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	if res.FICGateways, err = fic_gateways.ExtractFICGateways(pages); err != nil {
+		return nil, err
+	}
+
+	if c.VNA != nil {
+		pages, err = appliances.List(c.VNA, appliances.ListOpts{TenantID: tenantID}).AllPages()
+		if err != nil {
+			return nil, err
+		}
+		if res.Appliances, err = appliances.ExtractAppliances(pages); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.ManagedLoadBalancer != nil {
+		pages, err = load_balancers.List(c.ManagedLoadBalancer, load_balancers.ListOpts{TenantID: tenantID}).AllPages()
+		if err != nil {
+			return nil, err
+		}
+		lbs, err := load_balancers.ExtractLoadBalancers(pages)
+		if err != nil {
+			return nil, err
+		}
+		// Interfaces are only included when a load balancer is shown
+		// individually.
+		for i, lb := range lbs {
+			if len(lb.Interfaces) > 0 {
+				continue
+			}
+			detail, err := load_balancers.Show(c.ManagedLoadBalancer, lb.ID, nil).Extract()
+			if err != nil {
+				return nil, err
+			}
+			lbs[i] = *detail
+		}
+		res.LoadBalancers = lbs
+	}
+
+	return &res, nil
+}
+
+// NewGraph returns the graph of how the given resources connect. Connections
+// to resources which are not part of res are left out.
+func NewGraph(res Resources) *Graph {
+	g := &Graph{}
+
+	for _, n := range res.Networks {
+		g.AddNode(Node{
+			ID:     n.ID,
+			Kind:   KindNetwork,
+			Name:   n.Name,
+			Status: n.Status,
+			Attributes: map[string]string{
+				"plane": n.Plane,
+			},
+		})
+	}
+
+	for _, s := range res.Subnets {
+		g.AddNode(Node{
+			ID:     s.ID,
+			Kind:   KindSubnet,
+			Name:   s.Name,
+			Status: s.Status,
+			Attributes: map[string]string{
+				"cidr":       s.CIDR,
+				"gateway_ip": s.GatewayIP,
+			},
+		})
+		g.AddEdge(s.NetworkID, s.ID, "")
+	}
+
+	for _, p := range res.Ports {
+		var ips []string
+		for _, ip := range p.FixedIPs {
+			ips = append(ips, ip.IPAddress)
+		}
+		g.AddNode(Node{
+			ID:     p.ID,
+			Kind:   KindPort,
+			Name:   p.Name,
+			Status: p.Status,
+			Attributes: map[string]string{
+				"device_id":    p.DeviceID,
+				"device_owner": p.DeviceOwner,
+				"fixed_ips":    strings.Join(ips, ","),
+				"mac_address":  p.MACAddress,
+			},
+		})
+		g.AddEdge(p.NetworkID, p.ID, "")
+		for _, ip := range p.FixedIPs {
+			g.AddEdge(ip.SubnetID, p.ID, ip.IPAddress)
+		}
+		g.AddEdge(p.ID, p.DeviceID, "device")
+	}
+
+	for _, ig := range res.InternetGateways {
+		g.AddNode(Node{
+			ID:     ig.ID,
+			Kind:   KindInternetGateway,
+			Name:   ig.Name,
+			Status: ig.Status,
+			Attributes: map[string]string{
+				"internet_service_id": ig.InternetServiceID,
+				"qos_option_id":       ig.QoSOptionID,
+			},
+		})
+	}
+
+	for _, fg := range res.FICGateways {
+		g.AddNode(Node{
+			ID:     fg.ID,
+			Kind:   KindFICGateway,
+			Name:   fg.Name,
+			Status: fg.Status,
+			Attributes: map[string]string{
+				"fic_service_id": fg.FICServiceID,
+				"qos_option_id":  fg.QoSOptionID,
+			},
+		})
+	}
+
+	for _, gi := range res.GatewayInterfaces {
+		g.AddNode(Node{
+			ID:     gi.ID,
+			Kind:   KindGatewayInterface,
+			Name:   gi.Name,
+			Status: gi.Status,
+			Attributes: map[string]string{
+				"gw_vipv4":       gi.GwVipv4,
+				"primary_ipv4":   gi.PrimaryIpv4,
+				"secondary_ipv4": gi.SecondaryIpv4,
+				"netmask":        strconv.Itoa(gi.Netmask),
+				"service_type":   gi.ServiceType,
+				"vrid":           strconv.Itoa(gi.VRID),
+			},
+		})
+		g.AddEdge(gi.NetworkID, gi.ID, gi.GwVipv4)
+		g.AddEdge(gi.ID, gi.InternetGwID, "")
+		g.AddEdge(gi.ID, gi.FICGatewayID, "")
+	}
+
+	for _, ip := range res.PublicIPs {
+		g.AddNode(Node{
+			ID:     ip.ID,
+			Kind:   KindPublicIP,
+			Name:   ip.Name,
+			Status: ip.Status,
+			Attributes: map[string]string{
+				"cidr": fmt.Sprintf("%s/%d", ip.Cidr, ip.SubmaskLength),
+			},
+		})
+		g.AddEdge(ip.InternetGwID, ip.ID, "")
+	}
+
+	for _, sr := range res.StaticRoutes {
+		g.AddNode(Node{
+			ID:     sr.ID,
+			Kind:   KindStaticRoute,
+			Name:   sr.Name,
+			Status: sr.Status,
+			Attributes: map[string]string{
+				"destination":  sr.Destination,
+				"nexthop":      sr.Nexthop,
+				"service_type": sr.ServiceType,
+			},
+		})
+		label := fmt.Sprintf("%s via %s", sr.Destination, sr.Nexthop)
+		g.AddEdge(sr.InternetGwID, sr.ID, label)
+		g.AddEdge(sr.FICGatewayID, sr.ID, label)
+	}
+
+	for _, cfg := range res.CommonFunctionGateways {
+		g.AddNode(Node{
+			ID:     cfg.ID,
+			Kind:   KindCommonFunctionGateway,
+			Name:   cfg.Name,
+			Status: cfg.Status,
+			Attributes: map[string]string{
+				"common_function_pool_id": cfg.CommonFunctionPoolID,
+			},
+		})
+		g.AddEdge(cfg.ID, cfg.NetworkID, "")
+		g.AddEdge(cfg.SubnetID, cfg.ID, "")
+	}
+
+	for _, a := range res.Appliances {
+		g.AddNode(Node{
+			ID:     a.ID,
+			Kind:   KindVNAAppliance,
+			Name:   a.Name,
+			Status: a.OperationStatus,
+			Attributes: map[string]string{
+				"appliance_type":  a.ApplianceType,
+				"default_gateway": a.DefaultGateway,
+			},
+		})
+		for _, iface := range applianceInterfaces(a.Interfaces) {
+			var ips []string
+			for _, ip := range iface.FixedIPs {
+				ips = append(ips, ip.IPAddress)
+			}
+			g.AddEdge(iface.NetworkID, a.ID, strings.Join(ips, ","))
+		}
+	}
+
+	for _, lb := range res.LoadBalancers {
+		g.AddNode(Node{
+			ID:     lb.ID,
+			Kind:   KindLoadBalancer,
+			Name:   lb.Name,
+			Status: lb.OperationStatus,
+			Attributes: map[string]string{
+				"plan_id": lb.PlanID,
+			},
+		})
+		for _, iface := range lb.Interfaces {
+			g.AddEdge(iface.NetworkID, lb.ID, iface.VirtualIPAddress)
+		}
+	}
+
+	g.finalize()
+	return g
+}
+
+func applianceInterfaces(i appliances.InterfacesInResponse) []appliances.InterfaceInResponse {
+	all := []appliances.InterfaceInResponse{
+		i.Interface1, i.Interface2, i.Interface3, i.Interface4,
+		i.Interface5, i.Interface6, i.Interface7, i.Interface8,
+	}
+	var connected []appliances.InterfaceInResponse
+	for _, iface := range all {
+		if iface.NetworkID != "" {
+			connected = append(connected, iface)
+		}
+	}
+	return connected
+}
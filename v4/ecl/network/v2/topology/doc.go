@@ -0,0 +1,52 @@
+/*
+Package topology builds an in-memory graph of how the network resources of a
+tenant connect to each other, and exports it as JSON or Graphviz DOT.
+
+The graph covers networks, subnets, ports, internet gateways, gateway
+interfaces, public IPs, static routes, common function gateways and FIC
+gateways from the network service, plus virtual network appliances and
+managed load balancers when clients for those services are provided.
+
+Example to Export the Topology of a Tenant
+
+	clients := topology.Clients{
+		Network:             networkClient,
+		VNA:                 vnaClient,
+		ManagedLoadBalancer: mlbClient,
+	}
+
+	graph, err := topology.Build(clients, topology.BuildOpts{
+		TenantID: "dcb2d589c0c646d0bad45c0cf9f90cf1",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = graph.WriteDOT(os.Stdout)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Build a Graph from Resources Already Retrieved
+
+	graph := topology.NewGraph(topology.Resources{
+		Networks: allNetworks,
+		Subnets:  allSubnets,
+		Ports:    allPorts,
+	})
+
+	err := graph.WriteJSON(f)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Read a Graph Written as JSON
+
+	graph, err := topology.ReadJSON(f)
+	if err != nil {
+		panic(err)
+	}
+
+	subnet, ok := graph.Node(subnetID)
+*/
+package topology
@@ -0,0 +1,264 @@
+package topology
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// NodeKind identifies the type of resource a Node represents.
+type NodeKind string
+
+// Kinds of resources which may appear in a Graph.
+const (
+	KindNetwork               NodeKind = "network"
+	KindSubnet                NodeKind = "subnet"
+	KindPort                  NodeKind = "port"
+	KindInternetGateway       NodeKind = "internet_gateway"
+	KindGatewayInterface      NodeKind = "gateway_interface"
+	KindPublicIP              NodeKind = "public_ip"
+	KindStaticRoute           NodeKind = "static_route"
+	KindCommonFunctionGateway NodeKind = "common_function_gateway"
+	KindFICGateway            NodeKind = "fic_gateway"
+	KindVNAAppliance          NodeKind = "vna_appliance"
+	KindLoadBalancer          NodeKind = "mlb_load_balancer"
+)
+
+// dotShapes maps each kind of node to the Graphviz shape used to draw it.
+var dotShapes = map[NodeKind]string{
+	KindNetwork:               "box",
+	KindSubnet:                "box",
+	KindPort:                  "ellipse",
+	KindInternetGateway:       "doubleoctagon",
+	KindGatewayInterface:      "octagon",
+	KindPublicIP:              "note",
+	KindStaticRoute:           "cds",
+	KindCommonFunctionGateway: "octagon",
+	KindFICGateway:            "doubleoctagon",
+	KindVNAAppliance:          "component",
+	KindLoadBalancer:          "component",
+}
+
+// Node is a single resource in a Graph.
+type Node struct {
+	// ID is the unique ID of the resource.
+	ID string `json:"id"`
+
+	// Kind is the type of the resource.
+	Kind NodeKind `json:"kind"`
+
+	// Name is the human-readable name of the resource.
+	Name string `json:"name"`
+
+	// Status is the status of the resource, if it has one.
+	Status string `json:"status,omitempty"`
+
+	// Attributes holds additional kind-specific details, such as a subnet's
+	// CIDR.
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Edge is a connection between two nodes of a Graph.
+type Edge struct {
+	// From is the ID of the node the edge starts from.
+	From string `json:"from"`
+
+	// To is the ID of the node the edge points to.
+	To string `json:"to"`
+
+	// Label describes the connection, for example an IP address.
+	Label string `json:"label,omitempty"`
+}
+
+// Graph is an in-memory graph of network resources. Its zero value is an
+// empty graph ready to use.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+
+	nodes map[string]int
+	edges map[Edge]struct{}
+}
+
+// AddNode adds a node to the graph. Adding a node whose ID is already
+// present replaces the existing node.
+func (g *Graph) AddNode(n Node) {
+	if n.ID == "" {
+		return
+	}
+	if g.nodes == nil {
+		g.nodes = make(map[string]int)
+	}
+	if i, ok := g.nodes[n.ID]; ok {
+		g.Nodes[i] = n
+		return
+	}
+	g.nodes[n.ID] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, n)
+}
+
+// AddEdge adds an edge between two nodes of the graph. Edges which refer to
+// an empty ID are ignored, and adding the same edge twice has no effect.
+func (g *Graph) AddEdge(from, to, label string) {
+	if from == "" || to == "" {
+		return
+	}
+	if g.edges == nil {
+		g.edges = make(map[Edge]struct{})
+	}
+	e := Edge{From: from, To: to, Label: label}
+	if _, ok := g.edges[e]; ok {
+		return
+	}
+	g.edges[e] = struct{}{}
+	g.Edges = append(g.Edges, e)
+}
+
+// Node returns the node with the given ID, if it is part of the graph.
+func (g *Graph) Node(id string) (Node, bool) {
+	i, ok := g.nodes[id]
+	if !ok {
+		return Node{}, false
+	}
+	return g.Nodes[i], true
+}
+
+// Neighbors returns the IDs of the nodes connected to the node with the given
+// ID, in either direction.
+func (g *Graph) Neighbors(id string) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, e := range g.Edges {
+		var other string
+		switch id {
+		case e.From:
+			other = e.To
+		case e.To:
+			other = e.From
+		default:
+			continue
+		}
+		if !seen[other] {
+			seen[other] = true
+			ids = append(ids, other)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// finalize drops edges which refer to nodes outside the graph and sorts the
+// nodes and edges so that exports are stable.
+func (g *Graph) finalize() {
+	edges := g.Edges[:0]
+	for _, e := range g.Edges {
+		_, fromOK := g.nodes[e.From]
+		_, toOK := g.nodes[e.To]
+		if fromOK && toOK {
+			edges = append(edges, e)
+		} else {
+			delete(g.edges, e)
+		}
+	}
+	g.Edges = edges
+
+	sort.SliceStable(g.Nodes, func(i, j int) bool {
+		if g.Nodes[i].Kind != g.Nodes[j].Kind {
+			return g.Nodes[i].Kind < g.Nodes[j].Kind
+		}
+		return g.Nodes[i].ID < g.Nodes[j].ID
+	})
+	for i, n := range g.Nodes {
+		g.nodes[n.ID] = i
+	}
+
+	sort.SliceStable(g.Edges, func(i, j int) bool {
+		if g.Edges[i].From != g.Edges[j].From {
+			return g.Edges[i].From < g.Edges[j].From
+		}
+		if g.Edges[i].To != g.Edges[j].To {
+			return g.Edges[i].To < g.Edges[j].To
+		}
+		return g.Edges[i].Label < g.Edges[j].Label
+	})
+}
+
+// WriteDOT writes the graph in Graphviz DOT format.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "digraph topology {")
+	fmt.Fprintln(bw, "\trankdir=LR;")
+	for _, n := range g.Nodes {
+		label := string(n.Kind) + "\n" + n.Name
+		if cidr, ok := n.Attributes["cidr"]; ok {
+			label += "\n" + cidr
+		}
+		shape, ok := dotShapes[n.Kind]
+		if !ok {
+			shape = "ellipse"
+		}
+		fmt.Fprintf(bw, "\t%s [label=%s, shape=%s];\n", dotQuote(n.ID), dotQuote(label), shape)
+	}
+	for _, e := range g.Edges {
+		if e.Label == "" {
+			fmt.Fprintf(bw, "\t%s -> %s;\n", dotQuote(e.From), dotQuote(e.To))
+			continue
+		}
+		fmt.Fprintf(bw, "\t%s -> %s [label=%s];\n", dotQuote(e.From), dotQuote(e.To), dotQuote(e.Label))
+	}
+	fmt.Fprintln(bw, "}")
+
+	return bw.Flush()
+}
+
+// DOT returns the graph in Graphviz DOT format.
+func (g *Graph) DOT() (string, error) {
+	var sb strings.Builder
+	if err := g.WriteDOT(&sb); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// WriteJSON writes the graph as JSON. The output can be read back with
+// ReadJSON.
+func (g *Graph) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(g)
+}
+
+// ReadJSON reads a graph written by WriteJSON. Unlike decoding into a Graph
+// with encoding/json, it rebuilds the index used by Node, drops duplicate
+// nodes and edges, and drops edges which refer to nodes outside the graph.
+func ReadJSON(r io.Reader) (*Graph, error) {
+	var s struct {
+		Nodes []Node `json:"nodes"`
+		Edges []Edge `json:"edges"`
+	}
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, err
+	}
+
+	g := &Graph{
+		nodes: make(map[string]int),
+		edges: make(map[Edge]struct{}),
+	}
+	for _, n := range s.Nodes {
+		g.AddNode(n)
+	}
+	for _, e := range s.Edges {
+		g.AddEdge(e.From, e.To, e.Label)
+	}
+	g.finalize()
+
+	return g, nil
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
@@ -0,0 +1,305 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/gateway_interfaces"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/internet_gateways"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/networks"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/public_ips"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/static_routes"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/subnets"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/topology"
+)
+
+const NetworkListResponse = `
+{
+  "networks": [
+    {
+      "admin_state_up": true,
+      "id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+      "name": "Example Network",
+      "plane": "data",
+      "status": "ACTIVE",
+      "subnets": ["ab49eb24-667f-4a4e-9421-b4d915bff416"],
+      "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+    }
+  ]
+}
+`
+
+const SubnetListResponse = `
+{
+  "subnets": [
+    {
+      "cidr": "192.168.2.0/24",
+      "gateway_ip": "192.168.2.1",
+      "id": "ab49eb24-667f-4a4e-9421-b4d915bff416",
+      "ip_version": 4,
+      "name": "Example Subnet",
+      "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+      "status": "ACTIVE",
+      "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+    }
+  ]
+}
+`
+
+const PortListResponse = `
+{
+  "ports": [
+    {
+      "admin_state_up": true,
+      "device_id": "8e2ab4d7-66cf-4e25-8ba6-2e5b0d7c6a41",
+      "device_owner": "compute:zone1-groupb",
+      "fixed_ips": [
+        {
+          "ip_address": "192.168.2.10",
+          "subnet_id": "ab49eb24-667f-4a4e-9421-b4d915bff416"
+        }
+      ],
+      "id": "5f5b4e14-39c2-4b63-9f60-3e7e5ee2a4b0",
+      "mac_address": "fa:16:3e:11:22:33",
+      "name": "Example Port",
+      "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+      "status": "ACTIVE",
+      "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+    }
+  ]
+}
+`
+
+const InternetGatewayListResponse = `
+{
+  "internet_gateways": [
+    {
+      "description": "",
+      "id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+      "internet_service_id": "5536154d-9a00-4b11-81fb-b185c9111d90",
+      "name": "Example Internet Gateway",
+      "qos_option_id": "e497bbc3-1127-4490-a51d-93582c40ab40",
+      "status": "ACTIVE",
+      "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+    }
+  ]
+}
+`
+
+const GatewayInterfaceListResponse = `
+{
+  "gw_interfaces": [
+    {
+      "aws_gw_id": null,
+      "azure_gw_id": null,
+      "description": "",
+      "fic_gw_id": null,
+      "gcp_gw_id": null,
+      "gw_vipv4": "192.168.2.1",
+      "gw_vipv6": null,
+      "id": "09771fbb-6496-4ae1-9b53-226b6edcc1be",
+      "interdc_gw_id": null,
+      "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+      "name": "Example Gateway Interface",
+      "netmask": 24,
+      "network_id": "8f36b88a-443f-4d97-9751-34d34af9e782",
+      "primary_ipv4": "192.168.2.2",
+      "primary_ipv6": null,
+      "secondary_ipv4": "192.168.2.3",
+      "secondary_ipv6": null,
+      "service_type": "internet",
+      "status": "ACTIVE",
+      "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1",
+      "vpn_gw_id": null,
+      "vrid": 1
+    }
+  ]
+}
+`
+
+const PublicIPListResponse = `
+{
+  "public_ips": [
+    {
+      "cidr": "203.0.113.8",
+      "description": "",
+      "id": "0718a31b-67be-4349-946b-61a0fc38e4cd",
+      "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+      "name": "Example Public IP",
+      "status": "ACTIVE",
+      "submask_length": 29,
+      "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"
+    }
+  ]
+}
+`
+
+const StaticRouteListResponse = `
+{
+  "static_routes": [
+    {
+      "aws_gw_id": null,
+      "azure_gw_id": null,
+      "description": "",
+      "destination": "203.0.113.8/29",
+      "fic_gw_id": null,
+      "gcp_gw_id": null,
+      "id": "93aaec0f-1546-4062-88c5-93c397b93c03",
+      "interdc_gw_id": null,
+      "internet_gw_id": "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+      "name": "Example Static Route",
+      "nexthop": "192.168.2.10",
+      "service_type": "internet",
+      "status": "ACTIVE",
+      "tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1",
+      "vpn_gw_id": null
+    }
+  ]
+}
+`
+
+const CommonFunctionGatewayListResponse = `
+{
+  "common_function_gateways": []
+}
+`
+
+const FICGatewayListResponse = `
+{
+  "fic_gateways": []
+}
+`
+
+var ExampleResources = topology.Resources{
+	Networks: []networks.Network{
+		{
+			ID:     "8f36b88a-443f-4d97-9751-34d34af9e782",
+			Name:   "Example Network",
+			Plane:  "data",
+			Status: "ACTIVE",
+		},
+	},
+	Subnets: []subnets.Subnet{
+		{
+			ID:        "ab49eb24-667f-4a4e-9421-b4d915bff416",
+			NetworkID: "8f36b88a-443f-4d97-9751-34d34af9e782",
+			Name:      "Example Subnet",
+			CIDR:      "192.168.2.0/24",
+			GatewayIP: "192.168.2.1",
+			Status:    "ACTIVE",
+		},
+	},
+	Ports: []ports.Port{
+		{
+			ID:          "5f5b4e14-39c2-4b63-9f60-3e7e5ee2a4b0",
+			NetworkID:   "8f36b88a-443f-4d97-9751-34d34af9e782",
+			Name:        "Example Port",
+			Status:      "ACTIVE",
+			MACAddress:  "fa:16:3e:11:22:33",
+			DeviceID:    "8e2ab4d7-66cf-4e25-8ba6-2e5b0d7c6a41",
+			DeviceOwner: "compute:zone1-groupb",
+			FixedIPs: []ports.IP{
+				{
+					SubnetID:  "ab49eb24-667f-4a4e-9421-b4d915bff416",
+					IPAddress: "192.168.2.10",
+				},
+			},
+		},
+	},
+	InternetGateways: []internet_gateways.InternetGateway{
+		{
+			ID:                "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+			Name:              "Example Internet Gateway",
+			InternetServiceID: "5536154d-9a00-4b11-81fb-b185c9111d90",
+			QoSOptionID:       "e497bbc3-1127-4490-a51d-93582c40ab40",
+			Status:            "ACTIVE",
+		},
+	},
+	GatewayInterfaces: []gateway_interfaces.GatewayInterface{
+		{
+			ID:            "09771fbb-6496-4ae1-9b53-226b6edcc1be",
+			Name:          "Example Gateway Interface",
+			GwVipv4:       "192.168.2.1",
+			InternetGwID:  "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+			Netmask:       24,
+			NetworkID:     "8f36b88a-443f-4d97-9751-34d34af9e782",
+			PrimaryIpv4:   "192.168.2.2",
+			SecondaryIpv4: "192.168.2.3",
+			ServiceType:   "internet",
+			Status:        "ACTIVE",
+			VRID:          1,
+		},
+	},
+	PublicIPs: []public_ips.PublicIP{
+		{
+			Cidr:          "203.0.113.8",
+			ID:            "0718a31b-67be-4349-946b-61a0fc38e4cd",
+			InternetGwID:  "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+			Name:          "Example Public IP",
+			Status:        "ACTIVE",
+			SubmaskLength: 29,
+		},
+	},
+	StaticRoutes: []static_routes.StaticRoute{
+		{
+			Destination:  "203.0.113.8/29",
+			ID:           "93aaec0f-1546-4062-88c5-93c397b93c03",
+			InternetGwID: "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+			Name:         "Example Static Route",
+			Nexthop:      "192.168.2.10",
+			ServiceType:  "internet",
+			Status:       "ACTIVE",
+		},
+	},
+}
+
+var ExpectedEdges = []topology.Edge{
+	{
+		From: "09771fbb-6496-4ae1-9b53-226b6edcc1be",
+		To:   "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+	},
+	{
+		From:  "8f36b88a-443f-4d97-9751-34d34af9e782",
+		To:    "09771fbb-6496-4ae1-9b53-226b6edcc1be",
+		Label: "192.168.2.1",
+	},
+	{
+		From: "8f36b88a-443f-4d97-9751-34d34af9e782",
+		To:   "5f5b4e14-39c2-4b63-9f60-3e7e5ee2a4b0",
+	},
+	{
+		From: "8f36b88a-443f-4d97-9751-34d34af9e782",
+		To:   "ab49eb24-667f-4a4e-9421-b4d915bff416",
+	},
+	{
+		From:  "ab49eb24-667f-4a4e-9421-b4d915bff416",
+		To:    "5f5b4e14-39c2-4b63-9f60-3e7e5ee2a4b0",
+		Label: "192.168.2.10",
+	},
+	{
+		From: "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+		To:   "0718a31b-67be-4349-946b-61a0fc38e4cd",
+	},
+	{
+		From:  "e72ef35a-c96f-45f8-aeee-e7547c5b94b3",
+		To:    "93aaec0f-1546-4062-88c5-93c397b93c03",
+		Label: "203.0.113.8/29 via 192.168.2.10",
+	},
+}
+
+const ExpectedDOT = `digraph topology {
+	rankdir=LR;
+	"09771fbb-6496-4ae1-9b53-226b6edcc1be" [label="gateway_interface\nExample Gateway Interface", shape=octagon];
+	"e72ef35a-c96f-45f8-aeee-e7547c5b94b3" [label="internet_gateway\nExample Internet Gateway", shape=doubleoctagon];
+	"8f36b88a-443f-4d97-9751-34d34af9e782" [label="network\nExample Network", shape=box];
+	"5f5b4e14-39c2-4b63-9f60-3e7e5ee2a4b0" [label="port\nExample Port", shape=ellipse];
+	"0718a31b-67be-4349-946b-61a0fc38e4cd" [label="public_ip\nExample Public IP\n203.0.113.8/29", shape=note];
+	"93aaec0f-1546-4062-88c5-93c397b93c03" [label="static_route\nExample Static Route", shape=cds];
+	"ab49eb24-667f-4a4e-9421-b4d915bff416" [label="subnet\nExample Subnet\n192.168.2.0/24", shape=box];
+	"09771fbb-6496-4ae1-9b53-226b6edcc1be" -> "e72ef35a-c96f-45f8-aeee-e7547c5b94b3";
+	"8f36b88a-443f-4d97-9751-34d34af9e782" -> "09771fbb-6496-4ae1-9b53-226b6edcc1be" [label="192.168.2.1"];
+	"8f36b88a-443f-4d97-9751-34d34af9e782" -> "5f5b4e14-39c2-4b63-9f60-3e7e5ee2a4b0";
+	"8f36b88a-443f-4d97-9751-34d34af9e782" -> "ab49eb24-667f-4a4e-9421-b4d915bff416";
+	"ab49eb24-667f-4a4e-9421-b4d915bff416" -> "5f5b4e14-39c2-4b63-9f60-3e7e5ee2a4b0" [label="192.168.2.10"];
+	"e72ef35a-c96f-45f8-aeee-e7547c5b94b3" -> "0718a31b-67be-4349-946b-61a0fc38e4cd";
+	"e72ef35a-c96f-45f8-aeee-e7547c5b94b3" -> "93aaec0f-1546-4062-88c5-93c397b93c03" [label="203.0.113.8/29 via 192.168.2.10"];
+}
+`
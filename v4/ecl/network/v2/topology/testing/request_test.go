@@ -0,0 +1,140 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/topology"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+func TestNewGraph(t *testing.T) {
+	graph := topology.NewGraph(ExampleResources)
+
+	th.AssertEquals(t, 7, len(graph.Nodes))
+	th.CheckDeepEquals(t, ExpectedEdges, graph.Edges)
+
+	subnet, ok := graph.Node("ab49eb24-667f-4a4e-9421-b4d915bff416")
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, topology.KindSubnet, subnet.Kind)
+	th.AssertEquals(t, "192.168.2.0/24", subnet.Attributes["cidr"])
+
+	// The port's device is not part of the graph, so its edge is dropped.
+	neighbors := graph.Neighbors("5f5b4e14-39c2-4b63-9f60-3e7e5ee2a4b0")
+	th.CheckDeepEquals(t, []string{
+		"8f36b88a-443f-4d97-9751-34d34af9e782",
+		"ab49eb24-667f-4a4e-9421-b4d915bff416",
+	}, neighbors)
+}
+
+func TestGraphDOT(t *testing.T) {
+	graph := topology.NewGraph(ExampleResources)
+	dot, err := graph.DOT()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ExpectedDOT, dot)
+}
+
+func TestGraphWriteDOTError(t *testing.T) {
+	graph := topology.NewGraph(ExampleResources)
+	err := graph.WriteDOT(failingWriter{})
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, fmt.Errorf("write failed")
+}
+
+func TestGraphJSON(t *testing.T) {
+	graph := topology.NewGraph(ExampleResources)
+
+	b, err := json.Marshal(graph)
+	th.AssertNoErr(t, err)
+
+	var actual struct {
+		Nodes []topology.Node `json:"nodes"`
+		Edges []topology.Edge `json:"edges"`
+	}
+	th.AssertNoErr(t, json.Unmarshal(b, &actual))
+	th.CheckDeepEquals(t, graph.Nodes, actual.Nodes)
+	th.CheckDeepEquals(t, ExpectedEdges, actual.Edges)
+}
+
+func TestGraphReadJSON(t *testing.T) {
+	graph := topology.NewGraph(ExampleResources)
+
+	var buf bytes.Buffer
+	th.AssertNoErr(t, graph.WriteJSON(&buf))
+
+	actual, err := topology.ReadJSON(&buf)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, graph.Nodes, actual.Nodes)
+	th.CheckDeepEquals(t, ExpectedEdges, actual.Edges)
+
+	// The node index is rebuilt.
+	subnet, ok := actual.Node("ab49eb24-667f-4a4e-9421-b4d915bff416")
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, topology.KindSubnet, subnet.Kind)
+
+	// Edges to unknown nodes are dropped.
+	actual, err = topology.ReadJSON(strings.NewReader(`{
+		"nodes": [{"id": "a", "kind": "network", "name": "a"}],
+		"edges": [{"from": "a", "to": "b"}]
+	}`))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(actual.Edges))
+}
+
+func TestBuild(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	responses := map[string]string{
+		"networks":                 NetworkListResponse,
+		"subnets":                  SubnetListResponse,
+		"ports":                    PortListResponse,
+		"internet_gateways":        InternetGatewayListResponse,
+		"gw_interfaces":            GatewayInterfaceListResponse,
+		"public_ips":               PublicIPListResponse,
+		"static_routes":            StaticRouteListResponse,
+		"common_function_gateways": CommonFunctionGatewayListResponse,
+		"fic_gateways":             FICGatewayListResponse,
+	}
+	for path, response := range responses {
+		response := response
+		th.Mux.HandleFunc("/v2.0/"+path, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestFormValues(t, r, map[string]string{"tenant_id": "dcb2d589c0c646d0bad45c0cf9f90cf1"})
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			fmt.Fprintf(w, response)
+		})
+	}
+
+	clients := topology.Clients{Network: fake.ServiceClient()}
+	graph, err := topology.Build(clients, topology.BuildOpts{
+		TenantID: "dcb2d589c0c646d0bad45c0cf9f90cf1",
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 7, len(graph.Nodes))
+	th.CheckDeepEquals(t, ExpectedEdges, graph.Edges)
+}
+
+func TestBuildRequiresNetworkClient(t *testing.T) {
+	_, err := topology.Build(topology.Clients{}, topology.BuildOpts{})
+	if err == nil {
+		t.Fatal("Expected error for missing network client")
+	}
+}
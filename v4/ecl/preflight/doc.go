@@ -0,0 +1,48 @@
+/*
+Package preflight checks whether a set of servers, volumes and ports can be
+created within the quotas of a tenant before any of them is created.
+
+Plan looks up the flavors of the servers and the current usage and limits of
+the compute, network and volume quotas, and reports the amount of each
+resource requested against the amount still available. Volumes created by
+the block devices of boot-from-volume servers count against the volume
+quotas like the volumes given directly. Bulk provisioning can then be
+refused up front instead of failing halfway through.
+
+Example to Check Quotas Before Provisioning
+
+	clients := preflight.Clients{
+		Compute: computeClient,
+		Network: networkClient,
+		Volume:  volumeClient,
+	}
+
+	planOpts := preflight.PlanOpts{
+		TenantID: "tenant-id",
+		Servers: []servers.CreateOptsBuilder{
+			servers.CreateOpts{
+				Name:      "web-1",
+				ImageRef:  "image-uuid",
+				FlavorRef: "flavor-uuid",
+				Networks:  []servers.Network{{UUID: "network-uuid"}},
+			},
+		},
+		Volumes: []volumes.CreateOpts{
+			{Name: "data-1", Size: 100},
+		},
+	}
+
+	report, err := preflight.Plan(clients, planOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, check := range report.Exceeded() {
+		fmt.Println(check)
+	}
+
+	if err := report.Err(); err != nil {
+		panic(err)
+	}
+*/
+package preflight
@@ -0,0 +1,23 @@
+package preflight
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrQuotaExceeded is the error returned by Report.Err when the planned
+// resources do not fit into the quotas of the tenant.
+type ErrQuotaExceeded struct {
+	eclcloud.BaseError
+	Exceeded []Check
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	checks := make([]string, len(e.Exceeded))
+	for i, c := range e.Exceeded {
+		checks[i] = c.String()
+	}
+	return fmt.Sprintf("Quota would be exceeded: %s", strings.Join(checks, "; "))
+}
@@ -0,0 +1,381 @@
+package preflight
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/bootfromvolume"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/keypairs"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/limits"
+	computequotasets "github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/quotasets"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/schedulerhints"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/flavors"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/servers"
+	"github.com/nttcom/eclcloud/v4/ecl/computevolume/extensions/quotasets"
+	"github.com/nttcom/eclcloud/v4/ecl/computevolume/v2/volumes"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/quotas"
+)
+
+// Services whose quotas are checked.
+const (
+	ServiceCompute = "compute"
+	ServiceNetwork = "network"
+	ServiceVolume  = "volume"
+)
+
+// Resources whose quotas are checked.
+const (
+	ResourceInstances          = "instances"
+	ResourceCores              = "cores"
+	ResourceRAM                = "ram"
+	ResourceMetadataItems      = "metadata_items"
+	ResourcePorts              = "ports"
+	ResourceVolumes            = "volumes"
+	ResourceGigabytes          = "gigabytes"
+	ResourcePerVolumeGigabytes = "per_volume_gigabytes"
+)
+
+// Clients holds the service clients Plan uses. Only the clients of the
+// services a plan requests resources from are required.
+type Clients struct {
+	// Compute is a client created with ecl.NewComputeV2.
+	Compute *eclcloud.ServiceClient
+
+	// Network is a client created with ecl.NewNetworkV2.
+	Network *eclcloud.ServiceClient
+
+	// Volume is a client created with ecl.NewComputeVolumeV2.
+	Volume *eclcloud.ServiceClient
+}
+
+// PlanOpts describes the resources about to be created.
+type PlanOpts struct {
+	// TenantID is the tenant the resources are created in.
+	TenantID string
+
+	// Servers are the servers to be created, given as servers.CreateOpts or
+	// wrapped in the bootfromvolume, keypairs or schedulerhints CreateOptsExt.
+	// Each server counts against the instance, core and RAM quotas according
+	// to its flavor, and every network given without a port counts against
+	// the port quota. Block devices which create a volume count against the
+	// volume quotas.
+	Servers []servers.CreateOptsBuilder
+
+	// Volumes are the volumes to be created.
+	Volumes []volumes.CreateOpts
+
+	// Ports are the ports to be created.
+	Ports []ports.CreateOpts
+}
+
+// Check is the outcome of checking a single quota.
+type Check struct {
+	// Service is one of the Service constants.
+	Service string
+
+	// Resource is one of the Resource constants.
+	Resource string
+
+	// Limit is the quota of the resource, or -1 if it is unlimited.
+	Limit int
+
+	// InUse is the amount of the resource in use or reserved.
+	InUse int
+
+	// Requested is the amount of the resource the plan requires.
+	Requested int
+}
+
+// Available returns the amount of the resource which can still be used, or
+// -1 if the resource is unlimited.
+func (c Check) Available() int {
+	if c.Limit < 0 {
+		return -1
+	}
+	if c.InUse > c.Limit {
+		return 0
+	}
+	return c.Limit - c.InUse
+}
+
+// Exceeded reports whether the requested amount does not fit into the quota.
+func (c Check) Exceeded() bool {
+	return c.Requested > 0 && c.Limit >= 0 && c.Requested > c.Available()
+}
+
+func (c Check) String() string {
+	if c.Limit < 0 {
+		return fmt.Sprintf("%s %s: requested %d, unlimited", c.Service, c.Resource, c.Requested)
+	}
+	return fmt.Sprintf("%s %s: requested %d, %d of %d available",
+		c.Service, c.Resource, c.Requested, c.Available(), c.Limit)
+}
+
+// Report holds the checks performed by Plan.
+type Report struct {
+	Checks []Check
+}
+
+// Exceeded returns the checks whose quota would be exceeded.
+func (r Report) Exceeded() []Check {
+	var exceeded []Check
+	for _, c := range r.Checks {
+		if c.Exceeded() {
+			exceeded = append(exceeded, c)
+		}
+	}
+	return exceeded
+}
+
+// Err returns an ErrQuotaExceeded listing the exceeded checks, or nil if the
+// plan fits into the quotas.
+func (r Report) Err() error {
+	exceeded := r.Exceeded()
+	if len(exceeded) == 0 {
+		return nil
+	}
+	err := ErrQuotaExceeded{}
+	err.Exceeded = exceeded
+	return err
+}
+
+// Plan checks the resources described by opts against the quotas of the
+// tenant. Nothing is created; the returned Report lists the quotas of every
+// service resources are requested from. Call Report.Err to find out whether
+// any of them would be exceeded.
+func Plan(c Clients, opts PlanOpts) (*Report, error) {
+	if opts.TenantID == "" {
+		err := eclcloud.ErrMissingInput{}
+		err.Argument = "preflight.PlanOpts.TenantID"
+		return nil, err
+	}
+
+	p := plan{opts: opts}
+	for i, b := range opts.Servers {
+		s, devices, ok := unwrapServer(b)
+		if !ok {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = fmt.Sprintf("preflight.PlanOpts.Servers[%d]", i)
+			err.Value = b
+			return nil, err
+		}
+		p.servers = append(p.servers, s)
+		for _, bd := range devices {
+			if createsVolume(bd) {
+				p.volumeSizes = append(p.volumeSizes, bd.VolumeSize)
+			}
+		}
+	}
+	for _, v := range opts.Volumes {
+		p.volumeSizes = append(p.volumeSizes, v.Size)
+	}
+
+	report := &Report{}
+
+	if len(p.servers) > 0 {
+		checks, err := p.compute(c.Compute)
+		if err != nil {
+			return nil, err
+		}
+		report.Checks = append(report.Checks, checks...)
+	}
+
+	if p.portCount() > 0 {
+		checks, err := p.network(c.Network)
+		if err != nil {
+			return nil, err
+		}
+		report.Checks = append(report.Checks, checks...)
+	}
+
+	if len(p.volumeSizes) > 0 {
+		checks, err := p.volume(c.Volume)
+		if err != nil {
+			return nil, err
+		}
+		report.Checks = append(report.Checks, checks...)
+	}
+
+	return report, nil
+}
+
+// plan holds the resources of a PlanOpts once the server create options have
+// been unwrapped.
+type plan struct {
+	opts    PlanOpts
+	servers []servers.CreateOpts
+
+	// volumeSizes holds the size of every volume created, both those given in
+	// PlanOpts.Volumes and those created by the block devices of servers.
+	volumeSizes []int
+}
+
+// unwrapServer returns the servers.CreateOpts of b and its block devices,
+// looking through the CreateOptsExt of the compute extensions. It returns
+// false if b is of any other type.
+func unwrapServer(b servers.CreateOptsBuilder) (servers.CreateOpts, []bootfromvolume.BlockDevice, bool) {
+	switch o := b.(type) {
+	case servers.CreateOpts:
+		return o, nil, true
+	case *servers.CreateOpts:
+		return *o, nil, true
+	case bootfromvolume.CreateOptsExt:
+		s, devices, ok := unwrapServer(o.CreateOptsBuilder)
+		return s, append(devices, o.BlockDevice...), ok
+	case *bootfromvolume.CreateOptsExt:
+		return unwrapServer(*o)
+	case keypairs.CreateOptsExt:
+		return unwrapServer(o.CreateOptsBuilder)
+	case *keypairs.CreateOptsExt:
+		return unwrapServer(o.CreateOptsBuilder)
+	case schedulerhints.CreateOptsExt:
+		return unwrapServer(o.CreateOptsBuilder)
+	case *schedulerhints.CreateOptsExt:
+		return unwrapServer(o.CreateOptsBuilder)
+	}
+	return servers.CreateOpts{}, nil, false
+}
+
+// createsVolume reports whether bd creates a new volume. Block devices whose
+// source is an existing volume, and ephemeral disks, do not.
+func createsVolume(bd bootfromvolume.BlockDevice) bool {
+	return bd.DestinationType == bootfromvolume.DestinationVolume && bd.SourceType != bootfromvolume.SourceVolume
+}
+
+func (p plan) compute(client *eclcloud.ServiceClient) ([]Check, error) {
+	if client == nil {
+		return nil, missingClient("Compute")
+	}
+
+	var cores, ram, metadataItems int
+	cache := make(map[string]*flavors.Flavor)
+	for _, s := range p.servers {
+		flavor, err := getFlavor(client, s, cache)
+		if err != nil {
+			return nil, err
+		}
+		cores += flavor.VCPUs
+		ram += flavor.RAM
+		if len(s.Metadata) > metadataItems {
+			metadataItems = len(s.Metadata)
+		}
+	}
+
+	l, err := limits.Get(client, limits.GetOpts{TenantID: p.opts.TenantID}).Extract()
+	if err != nil {
+		return nil, err
+	}
+	a := l.Absolute
+
+	q, err := computequotasets.GetDetail(client, p.opts.TenantID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return []Check{
+		{ServiceCompute, ResourceInstances, a.MaxTotalInstances, a.TotalInstancesUsed, len(p.servers)},
+		{ServiceCompute, ResourceCores, a.MaxTotalCores, a.TotalCoresUsed, cores},
+		{ServiceCompute, ResourceRAM, a.MaxTotalRAMSize, a.TotalRAMUsed, ram},
+		// The metadata items of every server are limited separately, so
+		// nothing counts as in use and the largest set is what has to fit.
+		{ServiceCompute, ResourceMetadataItems, q.MetadataItems.Limit, 0, metadataItems},
+	}, nil
+}
+
+// getFlavor retrieves the flavor of s, looking it up by name if s has no
+// FlavorRef.
+func getFlavor(client *eclcloud.ServiceClient, s servers.CreateOpts, cache map[string]*flavors.Flavor) (*flavors.Flavor, error) {
+	id := s.FlavorRef
+	if id == "" {
+		if s.FlavorName == "" {
+			err := servers.ErrNeitherFlavorIDNorFlavorNameProvided{}
+			err.Argument = "FlavorRef/FlavorName"
+			return nil, err
+		}
+		if flavor, ok := cache["name:"+s.FlavorName]; ok {
+			return flavor, nil
+		}
+		var err error
+		id, err = flavors.IDFromName(client, s.FlavorName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	flavor, ok := cache[id]
+	if !ok {
+		var err error
+		flavor, err = flavors.Get(client, id).Extract()
+		if err != nil {
+			return nil, err
+		}
+		cache[id] = flavor
+	}
+	if s.FlavorName != "" {
+		cache["name:"+s.FlavorName] = flavor
+	}
+	return flavor, nil
+}
+
+// portCount returns the number of ports the plan creates: the ports given
+// explicitly and one for every server network given without a port.
+func (p plan) portCount() int {
+	count := len(p.opts.Ports)
+	for _, s := range p.servers {
+		for _, n := range s.Networks {
+			if n.Port == "" {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func (p plan) network(client *eclcloud.ServiceClient) ([]Check, error) {
+	if client == nil {
+		return nil, missingClient("Network")
+	}
+
+	q, err := quotas.GetDetail(client, p.opts.TenantID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return []Check{
+		{ServiceNetwork, ResourcePorts, q.Port.Limit, q.Port.Used + q.Port.Reserved, p.portCount()},
+	}, nil
+}
+
+func (p plan) volume(client *eclcloud.ServiceClient) ([]Check, error) {
+	if client == nil {
+		return nil, missingClient("Volume")
+	}
+
+	var gigabytes, largest int
+	for _, size := range p.volumeSizes {
+		gigabytes += size
+		if size > largest {
+			largest = size
+		}
+	}
+
+	q, err := quotasets.GetUsage(client, p.opts.TenantID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return []Check{
+		{ServiceVolume, ResourceVolumes, q.Volumes.Limit, q.Volumes.InUse + q.Volumes.Reserved, len(p.volumeSizes)},
+		{ServiceVolume, ResourceGigabytes, q.Gigabytes.Limit, q.Gigabytes.InUse + q.Gigabytes.Reserved, gigabytes},
+		// The size of every volume is limited separately, so nothing counts as
+		// in use and the largest volume is what has to fit.
+		{ServiceVolume, ResourcePerVolumeGigabytes, q.PerVolumeGigabytes.Limit, 0, largest},
+	}, nil
+}
+
+func missingClient(service string) error {
+	err := eclcloud.ErrMissingInput{}
+	err.Argument = "preflight.Clients." + service
+	return err
+}
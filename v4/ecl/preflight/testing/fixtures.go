@@ -0,0 +1,159 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4/ecl/preflight"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+// TenantID is the tenant the plans are checked for.
+const TenantID = "7d5b8a2b4e3f4c1a9e8d7c6b5a4f3e2d"
+
+// FlavorID is the ID of the flavor returned by FlavorOutput.
+const FlavorID = "1CPU-4GB"
+
+// FlavorOutput is a sample response to a flavors Get call.
+const FlavorOutput = `
+{
+    "flavor": {
+        "id": "1CPU-4GB",
+        "name": "1CPU-4GB",
+        "disk": 0,
+        "ram": 4096,
+        "vcpus": 1,
+        "swap": "",
+        "rxtx_factor": 1.0,
+        "os-flavor-access:is_public": true,
+        "OS-FLV-EXT-DATA:ephemeral": 0
+    }
+}
+`
+
+// LimitsOutput is a sample response to a limits Get call.
+const LimitsOutput = `
+{
+    "limits": {
+        "rate": [],
+        "absolute": {
+            "maxTotalInstances": 10,
+            "totalInstancesUsed": 8,
+            "maxTotalCores": 20,
+            "totalCoresUsed": 19,
+            "maxTotalRAMSize": -1,
+            "totalRAMUsed": 40960
+        }
+    }
+}
+`
+
+// ComputeQuotaOutput is a sample response to a compute quotasets GetDetail
+// call.
+const ComputeQuotaOutput = `
+{
+    "quota_set": {
+        "id": "7d5b8a2b4e3f4c1a9e8d7c6b5a4f3e2d",
+        "instances": {"in_use": 8, "reserved": 0, "limit": 10},
+        "cores": {"in_use": 19, "reserved": 0, "limit": 20},
+        "ram": {"in_use": 40960, "reserved": 0, "limit": -1},
+        "metadata_items": {"in_use": 0, "reserved": 0, "limit": 128},
+        "key_pairs": {"in_use": 1, "reserved": 0, "limit": 10}
+    }
+}
+`
+
+// NetworkQuotaOutput is a sample response to a network quotas GetDetail call.
+const NetworkQuotaOutput = `
+{
+    "quota": {
+        "network": {"used": 2, "reserved": 0, "limit": 10},
+        "port": {"used": 45, "reserved": 1, "limit": 50},
+        "security_group": {"used": 1, "reserved": 0, "limit": 10},
+        "security_group_rule": {"used": 4, "reserved": 0, "limit": 100},
+        "subnet": {"used": 2, "reserved": 0, "limit": 20}
+    }
+}
+`
+
+// VolumeQuotaOutput is a sample response to a volume quotasets GetUsage call.
+const VolumeQuotaOutput = `
+{
+    "quota_set": {
+        "id": "7d5b8a2b4e3f4c1a9e8d7c6b5a4f3e2d",
+        "volumes": {"in_use": 3, "allocated": 0, "reserved": 0, "limit": 10},
+        "snapshots": {"in_use": 0, "allocated": 0, "reserved": 0, "limit": 10},
+        "gigabytes": {"in_use": 800, "allocated": 0, "reserved": 0, "limit": 1000},
+        "per_volume_gigabytes": {"in_use": 0, "allocated": 0, "reserved": 0, "limit": 500},
+        "backups": {"in_use": 0, "allocated": 0, "reserved": 0, "limit": 10},
+        "backup_gigabytes": {"in_use": 0, "allocated": 0, "reserved": 0, "limit": 1000}
+    }
+}
+`
+
+// ExpectedChecks are the checks reported for the plan used in the tests.
+var ExpectedChecks = []preflight.Check{
+	{Service: preflight.ServiceCompute, Resource: preflight.ResourceInstances, Limit: 10, InUse: 8, Requested: 2},
+	{Service: preflight.ServiceCompute, Resource: preflight.ResourceCores, Limit: 20, InUse: 19, Requested: 2},
+	{Service: preflight.ServiceCompute, Resource: preflight.ResourceRAM, Limit: -1, InUse: 40960, Requested: 8192},
+	{Service: preflight.ServiceCompute, Resource: preflight.ResourceMetadataItems, Limit: 128, InUse: 0, Requested: 2},
+	{Service: preflight.ServiceNetwork, Resource: preflight.ResourcePorts, Limit: 50, InUse: 46, Requested: 3},
+	{Service: preflight.ServiceVolume, Resource: preflight.ResourceVolumes, Limit: 10, InUse: 3, Requested: 2},
+	{Service: preflight.ServiceVolume, Resource: preflight.ResourceGigabytes, Limit: 1000, InUse: 800, Requested: 150},
+	{Service: preflight.ServiceVolume, Resource: preflight.ResourcePerVolumeGigabytes, Limit: 500, InUse: 0, Requested: 100},
+}
+
+// HandleQuotasSuccessfully configures the test server to respond to the
+// flavor, limits and compute, network and volume quota requests made by
+// Plan. The flavor is expected to be retrieved only once.
+func HandleQuotasSuccessfully(t *testing.T) {
+	flavorCalls := 0
+	th.Mux.HandleFunc("/flavors/"+FlavorID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		flavorCalls++
+		if flavorCalls > 1 {
+			t.Errorf("flavor %s retrieved %d times", FlavorID, flavorCalls)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, FlavorOutput)
+	})
+
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"tenant_id": TenantID})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, LimitsOutput)
+	})
+
+	th.Mux.HandleFunc("/os-quota-sets/"+TenantID+"/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ComputeQuotaOutput)
+	})
+
+	th.Mux.HandleFunc("/v2.0/quotas/"+TenantID+"/details.json", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, NetworkQuotaOutput)
+	})
+
+	th.Mux.HandleFunc("/os-quota-sets/"+TenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"usage": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, VolumeQuotaOutput)
+	})
+}
@@ -0,0 +1,176 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/bootfromvolume"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/keypairs"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/servers"
+	"github.com/nttcom/eclcloud/v4/ecl/computevolume/v2/volumes"
+	fake "github.com/nttcom/eclcloud/v4/ecl/network/v2/common"
+	"github.com/nttcom/eclcloud/v4/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v4/ecl/preflight"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func planOpts() preflight.PlanOpts {
+	return preflight.PlanOpts{
+		TenantID: TenantID,
+		Servers: []servers.CreateOptsBuilder{
+			servers.CreateOpts{
+				Name:      "web-1",
+				ImageRef:  "image-uuid",
+				FlavorRef: FlavorID,
+				Networks: []servers.Network{
+					{UUID: "8f1c2b3a-0d4e-4f5a-9b6c-7d8e9f0a1b2c"},
+					{Port: "5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a"},
+				},
+				Metadata: map[string]string{"role": "web", "env": "prod"},
+			},
+			&servers.CreateOpts{
+				Name:      "web-2",
+				ImageRef:  "image-uuid",
+				FlavorRef: FlavorID,
+				Networks: []servers.Network{
+					{UUID: "8f1c2b3a-0d4e-4f5a-9b6c-7d8e9f0a1b2c"},
+				},
+			},
+		},
+		Volumes: []volumes.CreateOpts{
+			{Name: "data-1", Size: 100},
+			{Name: "data-2", Size: 50},
+		},
+		Ports: []ports.CreateOpts{
+			{NetworkID: "8f1c2b3a-0d4e-4f5a-9b6c-7d8e9f0a1b2c"},
+		},
+	}
+}
+
+func TestPlan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleQuotasSuccessfully(t)
+
+	clients := preflight.Clients{
+		Compute: client.ServiceClient(),
+		Network: fake.ServiceClient(),
+		Volume:  client.ServiceClient(),
+	}
+
+	report, err := preflight.Plan(clients, planOpts())
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedChecks, report.Checks)
+
+	exceeded := report.Exceeded()
+	th.AssertEquals(t, 1, len(exceeded))
+	th.AssertEquals(t, preflight.ResourceCores, exceeded[0].Resource)
+	th.AssertEquals(t, 1, exceeded[0].Available())
+
+	err = report.Err()
+	if _, ok := err.(preflight.ErrQuotaExceeded); !ok {
+		t.Fatalf("expected ErrQuotaExceeded, got %#v", err)
+	}
+	th.AssertEquals(t, "Quota would be exceeded: compute cores: requested 2, 1 of 20 available", err.Error())
+}
+
+func TestPlanWithinQuota(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleQuotasSuccessfully(t)
+
+	clients := preflight.Clients{
+		Network: fake.ServiceClient(),
+		Volume:  client.ServiceClient(),
+	}
+
+	opts := planOpts()
+	opts.Servers = nil
+
+	report, err := preflight.Plan(clients, opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 4, len(report.Checks))
+	th.AssertNoErr(t, report.Err())
+}
+
+func TestPlanBootFromVolume(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleQuotasSuccessfully(t)
+
+	clients := preflight.Clients{
+		Compute: client.ServiceClient(),
+		Volume:  client.ServiceClient(),
+	}
+
+	opts := preflight.PlanOpts{
+		TenantID: TenantID,
+		Servers: []servers.CreateOptsBuilder{
+			keypairs.CreateOptsExt{
+				CreateOptsBuilder: bootfromvolume.CreateOptsExt{
+					CreateOptsBuilder: servers.CreateOpts{
+						Name:      "db-1",
+						FlavorRef: FlavorID,
+					},
+					BlockDevice: []bootfromvolume.BlockDevice{
+						{SourceType: bootfromvolume.SourceImage, UUID: "image-uuid", DestinationType: bootfromvolume.DestinationVolume, VolumeSize: 40},
+						{SourceType: bootfromvolume.SourceBlank, DestinationType: bootfromvolume.DestinationVolume, VolumeSize: 200},
+						// Attaching an existing volume or an ephemeral disk
+						// creates no volume.
+						{SourceType: bootfromvolume.SourceVolume, UUID: "volume-uuid", DestinationType: bootfromvolume.DestinationVolume},
+						{SourceType: bootfromvolume.SourceBlank, DestinationType: bootfromvolume.DestinationLocal, VolumeSize: 10},
+					},
+				},
+				KeyName: "key",
+			},
+		},
+		Volumes: []volumes.CreateOpts{
+			{Name: "data-1", Size: 100},
+		},
+	}
+
+	report, err := preflight.Plan(clients, opts)
+	th.AssertNoErr(t, err)
+
+	expected := []preflight.Check{
+		{Service: preflight.ServiceVolume, Resource: preflight.ResourceVolumes, Limit: 10, InUse: 3, Requested: 3},
+		{Service: preflight.ServiceVolume, Resource: preflight.ResourceGigabytes, Limit: 1000, InUse: 800, Requested: 340},
+		{Service: preflight.ServiceVolume, Resource: preflight.ResourcePerVolumeGigabytes, Limit: 500, InUse: 0, Requested: 200},
+	}
+	th.CheckDeepEquals(t, expected, report.Checks[len(report.Checks)-3:])
+
+	exceeded := report.Exceeded()
+	th.AssertEquals(t, 1, len(exceeded))
+	th.AssertEquals(t, preflight.ResourceGigabytes, exceeded[0].Resource)
+}
+
+func TestPlanUnsupportedServer(t *testing.T) {
+	opts := preflight.PlanOpts{
+		TenantID: TenantID,
+		Servers:  []servers.CreateOptsBuilder{unsupportedServer{}},
+	}
+	_, err := preflight.Plan(preflight.Clients{}, opts)
+	if _, ok := err.(eclcloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %#v", err)
+	}
+}
+
+type unsupportedServer struct{}
+
+func (unsupportedServer) ToServerCreateMap() (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func TestPlanMissingClient(t *testing.T) {
+	_, err := preflight.Plan(preflight.Clients{}, planOpts())
+	if err == nil {
+		t.Fatal("expected an error without a compute client")
+	}
+}
+
+func TestCheckUnlimited(t *testing.T) {
+	c := preflight.Check{Limit: -1, InUse: 100, Requested: 1000}
+	th.AssertEquals(t, -1, c.Available())
+	th.AssertEquals(t, false, c.Exceeded())
+}
@@ -0,0 +1,44 @@
+/*
+Package sshkeys generates SSH key pairs locally so that only their public key
+is registered with the compute or baremetal keypairs API, and computes key
+fingerprints to match them against KeyPair.Fingerprint.
+
+Example to Generate and Register a Key Pair
+
+	kp, err := sshkeys.Generate(sshkeys.GenerateOpts{
+		Type:    sshkeys.KeyTypeED25519,
+		Comment: "deploy@example.com",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = kp.WritePrivateKey("/home/deploy/.ssh/id_ed25519")
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := sshkeys.CreateOpts{
+		Name:    "deploy",
+		KeyPair: kp,
+	}
+
+	keypair, err := keypairs.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	if !kp.Matches(keypair.Fingerprint) {
+		panic("registered key does not match the generated key")
+	}
+
+Example to Compute the Fingerprint of an Existing Public Key
+
+	fingerprint, err := sshkeys.Fingerprint("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMKvSk4yTtd1Rmwaq5mqyW0bgIe8Ai5qHrVHLi3w6n4X")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(fingerprint)
+*/
+package sshkeys
@@ -0,0 +1,18 @@
+package sshkeys
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrInvalidPublicKey is the error when a public key is not in the
+// authorized_keys format.
+type ErrInvalidPublicKey struct {
+	eclcloud.BaseError
+	PublicKey string
+}
+
+func (e ErrInvalidPublicKey) Error() string {
+	return fmt.Sprintf("Invalid public key %q: expected \"<type> <base64 key> [comment]\"", e.PublicKey)
+}
@@ -0,0 +1,308 @@
+package sshkeys
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// KeyType is the algorithm of a key pair.
+type KeyType string
+
+const (
+	// KeyTypeED25519 generates an Ed25519 key pair.
+	KeyTypeED25519 KeyType = "ed25519"
+
+	// KeyTypeRSA generates an RSA key pair.
+	KeyTypeRSA KeyType = "rsa"
+)
+
+// RSA key sizes in bits.
+const (
+	DefaultRSABits = 4096
+	MinRSABits     = 2048
+)
+
+// GenerateOpts specifies the key pair to generate.
+type GenerateOpts struct {
+	// Type is the algorithm of the key pair. It defaults to KeyTypeED25519.
+	Type KeyType
+
+	// Bits is the size of an RSA key. It defaults to DefaultRSABits and must
+	// be at least MinRSABits.
+	Bits int
+
+	// Comment is appended to the public key, usually user@host.
+	Comment string
+}
+
+// KeyPair is a locally generated SSH key pair.
+type KeyPair struct {
+	// Type is the algorithm of the key pair.
+	Type KeyType
+
+	// PublicKey is the public key in the authorized_keys format.
+	PublicKey string
+
+	// PrivateKey is the PEM encoded private key, in the OpenSSH format for
+	// Ed25519 keys and in the PKCS #1 format for RSA keys.
+	PrivateKey []byte
+
+	// Fingerprint is the MD5 fingerprint of the public key, in the format of
+	// KeyPair.Fingerprint returned by the keypairs API.
+	Fingerprint string
+
+	// FingerprintSHA256 is the SHA256 fingerprint of the public key, in the
+	// format printed by ssh-keygen.
+	FingerprintSHA256 string
+}
+
+// Generate generates a key pair locally.
+func Generate(opts GenerateOpts) (*KeyPair, error) {
+	if opts.Type == "" {
+		opts.Type = KeyTypeED25519
+	}
+
+	var blob, privateKey []byte
+	switch opts.Type {
+	case KeyTypeED25519:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		blob = ed25519Blob(pub)
+		privateKey, err = marshalED25519(pub, priv, opts.Comment)
+		if err != nil {
+			return nil, err
+		}
+	case KeyTypeRSA:
+		bits := opts.Bits
+		if bits == 0 {
+			bits = DefaultRSABits
+		}
+		if bits < MinRSABits {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = "sshkeys.GenerateOpts.Bits"
+			err.Value = bits
+			err.Info = fmt.Sprintf("RSA keys must have at least %d bits", MinRSABits)
+			return nil, err
+		}
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, err
+		}
+		blob = rsaBlob(&key.PublicKey)
+		privateKey = pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		})
+	default:
+		err := eclcloud.ErrInvalidInput{}
+		err.Argument = "sshkeys.GenerateOpts.Type"
+		err.Value = opts.Type
+		err.Info = fmt.Sprintf("Type must be %q or %q", KeyTypeED25519, KeyTypeRSA)
+		return nil, err
+	}
+
+	publicKey := keyTypeName(blob) + " " + base64.StdEncoding.EncodeToString(blob)
+	if opts.Comment != "" {
+		publicKey += " " + opts.Comment
+	}
+
+	return &KeyPair{
+		Type:              opts.Type,
+		PublicKey:         publicKey,
+		PrivateKey:        privateKey,
+		Fingerprint:       fingerprintMD5(blob),
+		FingerprintSHA256: fingerprintSHA256(blob),
+	}, nil
+}
+
+// Matches reports whether fingerprint, either in the MD5 format returned by
+// the keypairs API or in the SHA256 format, is the fingerprint of kp.
+func (kp KeyPair) Matches(fingerprint string) bool {
+	if strings.HasPrefix(fingerprint, "SHA256:") {
+		return fingerprint == kp.FingerprintSHA256
+	}
+	return strings.EqualFold(strings.TrimPrefix(fingerprint, "MD5:"), kp.Fingerprint)
+}
+
+// WritePrivateKey writes the private key to path, readable only by its
+// owner. An existing file is never overwritten. If writing fails, the
+// partially written file is removed so that the call can be retried.
+func (kp KeyPair) WritePrivateKey(path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(kp.PrivateKey)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
+
+// CreateOpts registers the public key of a locally generated key pair. It can
+// be passed to the Create function of both the compute and the baremetal
+// keypairs packages.
+type CreateOpts struct {
+	// Name is a friendly name to refer to this KeyPair in other services.
+	Name string `json:"name" required:"true"`
+
+	// KeyPair is the key pair whose public key is registered.
+	KeyPair *KeyPair `json:"-"`
+}
+
+// ToKeyPairCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToKeyPairCreateMap() (map[string]interface{}, error) {
+	if opts.KeyPair == nil || opts.KeyPair.PublicKey == "" {
+		err := eclcloud.ErrMissingInput{}
+		err.Argument = "sshkeys.CreateOpts.KeyPair"
+		return nil, err
+	}
+	b, err := eclcloud.BuildRequestBody(opts, "keypair")
+	if err != nil {
+		return nil, err
+	}
+	b["keypair"].(map[string]interface{})["public_key"] = opts.KeyPair.PublicKey
+	return b, nil
+}
+
+// Fingerprint returns the MD5 fingerprint of a public key in the
+// authorized_keys format, as returned in KeyPair.Fingerprint by the keypairs
+// API.
+func Fingerprint(publicKey string) (string, error) {
+	blob, err := parsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	return fingerprintMD5(blob), nil
+}
+
+// FingerprintSHA256 returns the SHA256 fingerprint of a public key in the
+// authorized_keys format, as printed by ssh-keygen.
+func FingerprintSHA256(publicKey string) (string, error) {
+	blob, err := parsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	return fingerprintSHA256(blob), nil
+}
+
+func parsePublicKey(publicKey string) ([]byte, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return nil, ErrInvalidPublicKey{PublicKey: publicKey}
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil || keyTypeName(blob) != fields[0] {
+		return nil, ErrInvalidPublicKey{PublicKey: publicKey}
+	}
+	return blob, nil
+}
+
+func fingerprintMD5(blob []byte) string {
+	sum := md5.Sum(blob)
+	hex := make([]string, len(sum))
+	for i, b := range sum {
+		hex[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(hex, ":")
+}
+
+func fingerprintSHA256(blob []byte) string {
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+// keyTypeName returns the key type stored at the start of a public key blob.
+func keyTypeName(blob []byte) string {
+	if len(blob) < 4 {
+		return ""
+	}
+	n := binary.BigEndian.Uint32(blob)
+	if uint64(n) > uint64(len(blob)-4) {
+		return ""
+	}
+	return string(blob[4 : 4+n])
+}
+
+func ed25519Blob(pub ed25519.PublicKey) []byte {
+	var buf bytes.Buffer
+	writeString(&buf, []byte("ssh-ed25519"))
+	writeString(&buf, pub)
+	return buf.Bytes()
+}
+
+func rsaBlob(pub *rsa.PublicKey) []byte {
+	var buf bytes.Buffer
+	writeString(&buf, []byte("ssh-rsa"))
+	writeString(&buf, mpint(big.NewInt(int64(pub.E))))
+	writeString(&buf, mpint(pub.N))
+	return buf.Bytes()
+}
+
+// marshalED25519 encodes an unencrypted private key in the OpenSSH format.
+func marshalED25519(pub ed25519.PublicKey, priv ed25519.PrivateKey, comment string) ([]byte, error) {
+	var check [4]byte
+	if _, err := rand.Read(check[:]); err != nil {
+		return nil, err
+	}
+
+	var private bytes.Buffer
+	private.Write(check[:])
+	private.Write(check[:])
+	writeString(&private, []byte("ssh-ed25519"))
+	writeString(&private, pub)
+	writeString(&private, priv)
+	writeString(&private, []byte(comment))
+	for i := byte(1); private.Len()%8 != 0; i++ {
+		private.WriteByte(i)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("openssh-key-v1\x00")
+	writeString(&buf, []byte("none"))
+	writeString(&buf, []byte("none"))
+	writeString(&buf, nil)
+	binary.Write(&buf, binary.BigEndian, uint32(1))
+	writeString(&buf, ed25519Blob(pub))
+	writeString(&buf, private.Bytes())
+
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "OPENSSH PRIVATE KEY",
+		Bytes: buf.Bytes(),
+	}), nil
+}
+
+// writeString writes b in the SSH wire format for strings.
+func writeString(buf *bytes.Buffer, b []byte) {
+	binary.Write(buf, binary.BigEndian, uint32(len(b)))
+	buf.Write(b)
+}
+
+// mpint encodes a non-negative integer in the SSH wire format for mpints.
+func mpint(n *big.Int) []byte {
+	b := n.Bytes()
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		b = append([]byte{0}, b...)
+	}
+	return b
+}
@@ -0,0 +1,2 @@
+// sshkeys unit tests
+package testing
@@ -0,0 +1,19 @@
+package testing
+
+// PublicKey is a public key generated by the keypairs API.
+const PublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAgQC+Eo/RZRngaGTkFs7I62ZjsIlO79KklKbMXi8F+KITD4bVQHHn+kV+4gRgkgCRbdoDqoGfpaDFs877DYX9n4z6FrAIZ4PES8TNKhatifpn9NdQYWA+IkU8CuvlEKGuFpKRi/k7JLos/gHi2hy7QUwgtRvcefvD/vgQZOVw/mGR9Q== Generated by Nova\n"
+
+// Fingerprint is the fingerprint of PublicKey returned by the keypairs API.
+const Fingerprint = "15:b0:f8:b3:f9:48:63:71:cf:7b:5b:38:6d:44:2d:4a"
+
+// CreateResponse is the response to a Create request importing a public key.
+const CreateResponse = `
+{
+	"keypair": {
+		"fingerprint": "%s",
+		"name": "deploy",
+		"public_key": "%s",
+		"user_id": "fake"
+	}
+}
+`
@@ -0,0 +1,153 @@
+package testing
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	baremetalkeypairs "github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/keypairs"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/extensions/keypairs"
+	"github.com/nttcom/eclcloud/v4/ecl/sshkeys"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+	"github.com/nttcom/eclcloud/v4/testhelper/client"
+)
+
+func TestFingerprint(t *testing.T) {
+	fingerprint, err := sshkeys.Fingerprint(PublicKey)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, Fingerprint, fingerprint)
+
+	_, err = sshkeys.Fingerprint("ssh-rsa not-base64")
+	if _, ok := err.(sshkeys.ErrInvalidPublicKey); !ok {
+		t.Fatalf("expected ErrInvalidPublicKey, got %#v", err)
+	}
+
+	_, err = sshkeys.Fingerprint(strings.Replace(PublicKey, "ssh-rsa", "ssh-ed25519", 1))
+	if _, ok := err.(sshkeys.ErrInvalidPublicKey); !ok {
+		t.Fatalf("expected ErrInvalidPublicKey for a mismatched key type, got %#v", err)
+	}
+}
+
+func TestGenerateED25519(t *testing.T) {
+	kp, err := sshkeys.Generate(sshkeys.GenerateOpts{Comment: "deploy@example.com"})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, sshkeys.KeyTypeED25519, kp.Type)
+	fields := strings.Fields(kp.PublicKey)
+	th.AssertEquals(t, 3, len(fields))
+	th.AssertEquals(t, "ssh-ed25519", fields[0])
+	th.AssertEquals(t, "deploy@example.com", fields[2])
+
+	block, _ := pem.Decode(kp.PrivateKey)
+	th.AssertEquals(t, "OPENSSH PRIVATE KEY", block.Type)
+	th.AssertEquals(t, true, strings.HasPrefix(string(block.Bytes), "openssh-key-v1\x00"))
+
+	fingerprint, err := sshkeys.Fingerprint(kp.PublicKey)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, kp.Fingerprint, fingerprint)
+
+	fingerprint, err = sshkeys.FingerprintSHA256(kp.PublicKey)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, kp.FingerprintSHA256, fingerprint)
+
+	th.AssertEquals(t, true, kp.Matches(kp.Fingerprint))
+	th.AssertEquals(t, true, kp.Matches(strings.ToUpper(kp.Fingerprint)))
+	th.AssertEquals(t, true, kp.Matches(kp.FingerprintSHA256))
+	th.AssertEquals(t, false, kp.Matches(Fingerprint))
+}
+
+func TestGenerateRSA(t *testing.T) {
+	kp, err := sshkeys.Generate(sshkeys.GenerateOpts{Type: sshkeys.KeyTypeRSA, Bits: 2048})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, true, strings.HasPrefix(kp.PublicKey, "ssh-rsa AAAAB3NzaC1yc2E"))
+	block, _ := pem.Decode(kp.PrivateKey)
+	th.AssertEquals(t, "RSA PRIVATE KEY", block.Type)
+
+	_, err = sshkeys.Generate(sshkeys.GenerateOpts{Type: sshkeys.KeyTypeRSA, Bits: 1024})
+	if err == nil {
+		t.Fatal("expected an error for a 1024 bit RSA key")
+	}
+
+	_, err = sshkeys.Generate(sshkeys.GenerateOpts{Type: "dsa"})
+	if err == nil {
+		t.Fatal("expected an error for an unsupported key type")
+	}
+}
+
+func TestWritePrivateKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sshkeys")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	kp, err := sshkeys.Generate(sshkeys.GenerateOpts{})
+	th.AssertNoErr(t, err)
+
+	path := filepath.Join(dir, "id_ed25519")
+	th.AssertNoErr(t, kp.WritePrivateKey(path))
+
+	info, err := os.Stat(path)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, os.FileMode(0600), info.Mode().Perm())
+
+	written, err := ioutil.ReadFile(path)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, string(kp.PrivateKey), string(written))
+
+	if err := kp.WritePrivateKey(path); err == nil {
+		t.Fatal("expected an error when overwriting an existing key")
+	}
+}
+
+func handleCreate(t *testing.T, path string, kp *sshkeys.KeyPair) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		publicKey, _ := json.Marshal(kp.PublicKey)
+		th.TestJSONRequest(t, r, fmt.Sprintf(`{"keypair": {"name": "deploy", "public_key": %s}}`, publicKey))
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, CreateResponse, kp.Fingerprint, kp.PublicKey)
+	})
+}
+
+func TestRegisterCompute(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	kp, err := sshkeys.Generate(sshkeys.GenerateOpts{})
+	th.AssertNoErr(t, err)
+	handleCreate(t, "/os-keypairs", kp)
+
+	keypair, err := keypairs.Create(client.ServiceClient(), sshkeys.CreateOpts{Name: "deploy", KeyPair: kp}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", keypair.PrivateKey)
+	th.AssertEquals(t, true, kp.Matches(keypair.Fingerprint))
+}
+
+func TestRegisterBaremetal(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	kp, err := sshkeys.Generate(sshkeys.GenerateOpts{})
+	th.AssertNoErr(t, err)
+	handleCreate(t, "/os-keypairs", kp)
+
+	keypair, err := baremetalkeypairs.Create(client.ServiceClient(), sshkeys.CreateOpts{Name: "deploy", KeyPair: kp}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, kp.Matches(keypair.Fingerprint))
+}
+
+func TestRegisterWithoutKeyPair(t *testing.T) {
+	_, err := sshkeys.CreateOpts{Name: "deploy"}.ToKeyPairCreateMap()
+	if err == nil {
+		t.Fatal("expected an error without a key pair")
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Package userdata composes cloud-init user data for compute and baremetal
+servers.
+
+Opts describes a cloud-config document, the files to write, the SSH keys to
+authorize and shell scripts to run. Build renders them as a single
+cloud-config document, or as a multi-part MIME document when scripts are
+present, optionally compresses it with gzip, and returns it base64 encoded so
+that it can be assigned to the UserData field of either compute or baremetal
+servers.CreateOpts as is. Build fails with ErrUserDataTooLarge when the result
+exceeds MaxSize.
+
+Example to Create a Server With Composed User Data
+
+	userDataOpts := userdata.Opts{
+		CloudConfig: map[string]interface{}{
+			"package_update": true,
+			"packages":       []string{"nginx"},
+		},
+		SSHAuthorizedKeys: []string{
+			"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMKvSk4yTtd1Rmwaq5mqyW0bgIe8Ai5qHrVHLi3w6n4X ops@example.com",
+		},
+		WriteFiles: []userdata.File{
+			{
+				Path:        "/etc/nginx/conf.d/health.conf",
+				Content:     "server {\n  listen 8080;\n  location /health { return 200; }\n}\n",
+				Permissions: "0644",
+			},
+		},
+		Scripts: []userdata.Script{
+			{
+				Name:    "enable-nginx.sh",
+				Content: "#!/bin/sh\nsystemctl enable --now nginx\n",
+			},
+		},
+		Gzip: true,
+	}
+
+	userData, err := userdata.Build(userDataOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := servers.CreateOpts{
+		Name:      "server_name",
+		ImageRef:  "image-uuid",
+		FlavorRef: "flavor-uuid",
+		UserData:  userData,
+	}
+
+	server, err := servers.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package userdata
@@ -0,0 +1,19 @@
+package userdata
+
+import (
+	"fmt"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// ErrUserDataTooLarge is the error when the encoded user data exceeds the
+// size accepted by the API.
+type ErrUserDataTooLarge struct {
+	eclcloud.BaseError
+	Size  int
+	Limit int
+}
+
+func (e ErrUserDataTooLarge) Error() string {
+	return fmt.Sprintf("User data is %d bytes after encoding, the limit is %d bytes", e.Size, e.Limit)
+}
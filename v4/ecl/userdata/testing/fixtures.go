@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"github.com/nttcom/eclcloud/v4/ecl/userdata"
+)
+
+// CloudConfigOpts renders as a single cloud-config document.
+var CloudConfigOpts = userdata.Opts{
+	CloudConfig: map[string]interface{}{
+		"package_update": true,
+		"packages":       []string{"nginx", "jq"},
+		"runcmd": []interface{}{
+			[]string{"systemctl", "enable", "--now", "nginx"},
+			"echo done > /var/log/provisioned",
+		},
+		"timezone": "Asia/Tokyo",
+		"users": []interface{}{
+			"default",
+			map[string]interface{}{
+				"name":   "deploy",
+				"groups": "wheel",
+				"sudo":   "ALL=(ALL) NOPASSWD:ALL",
+				"shell":  "/bin/bash",
+			},
+		},
+	},
+	SSHAuthorizedKeys: []string{
+		"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMKvSk4yTtd1Rmwaq5mqyW0bgIe8Ai5qHrVHLi3w6n4X ops@example.com",
+	},
+	WriteFiles: []userdata.File{
+		{
+			Path:        "/etc/nginx/conf.d/health.conf",
+			Content:     "server {\n  listen 8080;\n\n  location /health { return 200; }\n}\n",
+			Owner:       "root:root",
+			Permissions: "0644",
+		},
+		{
+			Path:    "/etc/motd",
+			Content: "managed by cloud-init",
+			Append:  true,
+		},
+		{
+			Path:        "/var/lib/app/seed.bin",
+			Content:     "\x00\xff\xfe",
+			Permissions: "0600",
+			Defer:       true,
+		},
+	},
+}
+
+// MultipartOpts renders as a multi-part document with a cloud-config part
+// and two shell script parts.
+var MultipartOpts = userdata.Opts{
+	CloudConfig: map[string]interface{}{
+		"packages": []string{"nginx"},
+	},
+	SSHAuthorizedKeys: []string{
+		"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMKvSk4yTtd1Rmwaq5mqyW0bgIe8Ai5qHrVHLi3w6n4X ops@example.com",
+	},
+	Scripts: []userdata.Script{
+		{
+			Name:    "enable-nginx.sh",
+			Content: "#!/bin/sh\nsystemctl enable --now nginx\n",
+		},
+		{
+			Name:    "greet.sh",
+			Content: "#!/bin/bash\necho \"こんにちは\" > /etc/greeting",
+		},
+	},
+}
+
+// ScriptOnlyOpts renders as a multi-part document with a single shell script
+// part.
+var ScriptOnlyOpts = userdata.Opts{
+	Scripts: []userdata.Script{
+		{
+			Name:    "hello.sh",
+			Content: "#!/bin/sh\necho hello\n",
+		},
+	},
+}
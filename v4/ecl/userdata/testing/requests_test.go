@@ -0,0 +1,164 @@
+package testing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"flag"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v4"
+	baremetal "github.com/nttcom/eclcloud/v4/ecl/baremetal/v2/servers"
+	"github.com/nttcom/eclcloud/v4/ecl/compute/v2/servers"
+	"github.com/nttcom/eclcloud/v4/ecl/userdata"
+	th "github.com/nttcom/eclcloud/v4/testhelper"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func checkGolden(t *testing.T, name string, actual []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := ioutil.WriteFile(path, actual, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := ioutil.ReadFile(path)
+	th.AssertNoErr(t, err)
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("%s does not match the golden file:\n%s", name, actual)
+	}
+}
+
+func TestRenderCloudConfig(t *testing.T) {
+	actual, err := userdata.Render(CloudConfigOpts)
+	th.AssertNoErr(t, err)
+	checkGolden(t, "cloud_config", actual)
+}
+
+func TestRenderMultipart(t *testing.T) {
+	actual, err := userdata.Render(MultipartOpts)
+	th.AssertNoErr(t, err)
+	checkGolden(t, "multipart", actual)
+}
+
+func TestRenderScriptOnly(t *testing.T) {
+	actual, err := userdata.Render(ScriptOnlyOpts)
+	th.AssertNoErr(t, err)
+	checkGolden(t, "script_only", actual)
+}
+
+func TestRenderGzip(t *testing.T) {
+	opts := MultipartOpts
+	opts.Gzip = true
+
+	compressed, err := userdata.Render(opts)
+	th.AssertNoErr(t, err)
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	th.AssertNoErr(t, err)
+	actual, err := ioutil.ReadAll(r)
+	th.AssertNoErr(t, err)
+	checkGolden(t, "multipart", actual)
+}
+
+func TestBuildCreateOpts(t *testing.T) {
+	userData, err := userdata.Build(CloudConfigOpts)
+	th.AssertNoErr(t, err)
+
+	decoded, err := base64.StdEncoding.DecodeString(string(userData))
+	th.AssertNoErr(t, err)
+	checkGolden(t, "cloud_config", decoded)
+
+	computeOpts := servers.CreateOpts{
+		Name:      "server",
+		ImageRef:  "image-uuid",
+		FlavorRef: "flavor-uuid",
+		UserData:  userData,
+	}
+	b, err := computeOpts.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, string(userData), *b["server"].(map[string]interface{})["user_data"].(*string))
+
+	baremetalOpts := baremetal.CreateOpts{
+		Name:      "server",
+		Networks:  []baremetal.CreateOptsNetwork{{UUID: "network-uuid"}},
+		FlavorRef: "flavor-uuid",
+		UserData:  userData,
+	}
+	b, err = baremetalOpts.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, string(userData), *b["server"].(map[string]interface{})["user_data"].(*string))
+}
+
+func TestBuildTooLarge(t *testing.T) {
+	noise := make([]byte, userdata.MaxSize)
+	rand.New(rand.NewSource(1)).Read(noise)
+
+	opts := userdata.Opts{
+		Scripts: []userdata.Script{
+			{
+				Name:    "large.sh",
+				Content: "#!/bin/sh\n# " + base64.StdEncoding.EncodeToString(noise),
+			},
+		},
+		Gzip: true,
+	}
+
+	_, err := userdata.Build(opts)
+	if _, ok := err.(userdata.ErrUserDataTooLarge); !ok {
+		t.Fatalf("expected ErrUserDataTooLarge, got %#v", err)
+	}
+}
+
+func TestBuildInvalidOpts(t *testing.T) {
+	_, err := userdata.Build(userdata.Opts{})
+	if err == nil {
+		t.Error("expected an error for empty options")
+	}
+
+	_, err = userdata.Build(userdata.Opts{
+		Scripts: []userdata.Script{{Name: "run.sh", Content: "echo hello"}},
+	})
+	if err == nil {
+		t.Error("expected an error for a script without interpreter line")
+	}
+
+	_, err = userdata.Build(userdata.Opts{
+		CloudConfig:       map[string]interface{}{"ssh_authorized_keys": []string{"ssh-rsa AAAA"}},
+		SSHAuthorizedKeys: []string{"ssh-rsa BBBB"},
+	})
+	if err == nil {
+		t.Error("expected an error for conflicting ssh_authorized_keys")
+	}
+}
+
+func TestRenderUnsupportedValue(t *testing.T) {
+	_, err := userdata.Render(userdata.Opts{
+		CloudConfig: map[string]interface{}{
+			"bootcmd": []interface{}{"echo hello", func() {}},
+		},
+	})
+	e, ok := err.(eclcloud.ErrInvalidInput)
+	if !ok {
+		t.Fatalf("expected ErrInvalidInput, got %#v", err)
+	}
+	th.AssertEquals(t, "userdata.Opts.CloudConfig.bootcmd[1]", e.Argument)
+
+	_, err = userdata.Render(userdata.Opts{
+		CloudConfig: map[string]interface{}{
+			"mounts": map[int]string{1: "/dev/vdb"},
+		},
+	})
+	e, ok = err.(eclcloud.ErrInvalidInput)
+	if !ok {
+		t.Fatalf("expected ErrInvalidInput, got %#v", err)
+	}
+	th.AssertEquals(t, "userdata.Opts.CloudConfig.mounts", e.Argument)
+}
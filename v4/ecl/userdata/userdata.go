@@ -0,0 +1,306 @@
+package userdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// MaxSize is the maximum size in bytes of base64 encoded user data accepted
+// by the API.
+const MaxSize = 65535
+
+// Content types of the parts of a multi-part document.
+const (
+	ContentTypeCloudConfig = "text/cloud-config"
+	ContentTypeShellScript = "text/x-shellscript"
+)
+
+// File is a file written by cloud-init through write_files.
+type File struct {
+	// Path is the absolute path of the file.
+	Path string
+
+	// Content is the content of the file. Content which is not valid UTF-8 is
+	// written base64 encoded.
+	Content string
+
+	// Owner is the owner of the file, such as "root:root".
+	Owner string
+
+	// Permissions are the octal permissions of the file, such as "0644".
+	Permissions string
+
+	// Append appends Content to the file instead of overwriting it.
+	Append bool
+
+	// Defer writes the file after users and packages have been set up.
+	Defer bool
+}
+
+// Script is a shell script run by cloud-init once on first boot.
+type Script struct {
+	// Name is the file name of the script.
+	Name string
+
+	// Content is the script. It must start with an interpreter line such as
+	// "#!/bin/sh".
+	Content string
+}
+
+// Opts specifies the content of the user data.
+type Opts struct {
+	// CloudConfig holds cloud-config keys, such as "packages" or "runcmd".
+	// Values may be strings, booleans, numbers, and slices and string keyed
+	// maps of those.
+	CloudConfig map[string]interface{}
+
+	// SSHAuthorizedKeys are added to ssh_authorized_keys of the default user.
+	SSHAuthorizedKeys []string
+
+	// WriteFiles are added to write_files.
+	WriteFiles []File
+
+	// Scripts are added as shell script parts of a multi-part document.
+	Scripts []Script
+
+	// Gzip compresses the document.
+	Gzip bool
+}
+
+// Render composes the user data document described by opts, compressed if
+// opts.Gzip is set. The document is not base64 encoded and its size is not
+// checked; use Build for user data passed to CreateOpts.
+func Render(opts Opts) ([]byte, error) {
+	parts, err := opts.parts()
+	if err != nil {
+		return nil, err
+	}
+
+	var doc []byte
+	if len(parts) == 1 && parts[0].contentType == ContentTypeCloudConfig {
+		doc = parts[0].body
+	} else {
+		doc, err = multipart(parts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !opts.Gzip {
+		return doc, nil
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(doc); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Build composes the user data document described by opts and returns it
+// base64 encoded, ready to be used as the UserData of compute and baremetal
+// servers.CreateOpts. An ErrUserDataTooLarge is returned if the encoded
+// document is larger than MaxSize.
+func Build(opts Opts) ([]byte, error) {
+	doc, err := Render(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := make([]byte, base64.StdEncoding.EncodedLen(len(doc)))
+	base64.StdEncoding.Encode(encoded, doc)
+
+	if len(encoded) > MaxSize {
+		err := ErrUserDataTooLarge{}
+		err.Size = len(encoded)
+		err.Limit = MaxSize
+		return nil, err
+	}
+	return encoded, nil
+}
+
+type part struct {
+	contentType string
+	filename    string
+	body        []byte
+
+	// argument names the option the part was built from, for errors.
+	argument string
+}
+
+func (opts Opts) parts() ([]part, error) {
+	var parts []part
+
+	config, err := opts.cloudConfig()
+	if err != nil {
+		return nil, err
+	}
+	if len(config) > 0 {
+		body, err := encodeYAML("userdata.Opts.CloudConfig", config)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part{
+			contentType: ContentTypeCloudConfig,
+			filename:    "cloud-config.txt",
+			body:        append([]byte("#cloud-config\n"), body...),
+			argument:    "userdata.Opts.CloudConfig",
+		})
+	}
+
+	for i, s := range opts.Scripts {
+		if s.Name == "" {
+			err := eclcloud.ErrMissingInput{}
+			err.Argument = fmt.Sprintf("userdata.Opts.Scripts[%d].Name", i)
+			return nil, err
+		}
+		if !strings.HasPrefix(s.Content, "#!") {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = fmt.Sprintf("userdata.Opts.Scripts[%d].Content", i)
+			err.Value = s.Name
+			err.Info = fmt.Sprintf("Script %s must start with an interpreter line such as #!/bin/sh", s.Name)
+			return nil, err
+		}
+		parts = append(parts, part{
+			contentType: ContentTypeShellScript,
+			filename:    s.Name,
+			body:        []byte(s.Content),
+			argument:    fmt.Sprintf("userdata.Opts.Scripts[%d].Content", i),
+		})
+	}
+
+	if len(parts) == 0 {
+		err := eclcloud.ErrMissingInput{}
+		err.Argument = "userdata.Opts"
+		return nil, err
+	}
+	return parts, nil
+}
+
+// cloudConfig merges SSHAuthorizedKeys and WriteFiles into CloudConfig.
+func (opts Opts) cloudConfig() (map[string]interface{}, error) {
+	config := make(map[string]interface{}, len(opts.CloudConfig)+2)
+	for k, v := range opts.CloudConfig {
+		config[k] = v
+	}
+
+	if len(opts.SSHAuthorizedKeys) > 0 {
+		if _, ok := config["ssh_authorized_keys"]; ok {
+			return nil, conflict("SSHAuthorizedKeys", "ssh_authorized_keys")
+		}
+		config["ssh_authorized_keys"] = opts.SSHAuthorizedKeys
+	}
+
+	if len(opts.WriteFiles) > 0 {
+		if _, ok := config["write_files"]; ok {
+			return nil, conflict("WriteFiles", "write_files")
+		}
+		files := make([]interface{}, len(opts.WriteFiles))
+		for i, f := range opts.WriteFiles {
+			if f.Path == "" {
+				err := eclcloud.ErrMissingInput{}
+				err.Argument = fmt.Sprintf("userdata.Opts.WriteFiles[%d].Path", i)
+				return nil, err
+			}
+			files[i] = f.toMap()
+		}
+		config["write_files"] = files
+	}
+
+	return config, nil
+}
+
+func (f File) toMap() map[string]interface{} {
+	m := map[string]interface{}{
+		"path": f.Path,
+	}
+	if utf8.ValidString(f.Content) {
+		m["content"] = f.Content
+	} else {
+		m["content"] = base64.StdEncoding.EncodeToString([]byte(f.Content))
+		m["encoding"] = "b64"
+	}
+	if f.Owner != "" {
+		m["owner"] = f.Owner
+	}
+	if f.Permissions != "" {
+		m["permissions"] = f.Permissions
+	}
+	if f.Append {
+		m["append"] = true
+	}
+	if f.Defer {
+		m["defer"] = true
+	}
+	return m
+}
+
+func conflict(field, key string) error {
+	err := eclcloud.ErrInvalidInput{}
+	err.Argument = "userdata.Opts.CloudConfig"
+	err.Value = key
+	err.Info = fmt.Sprintf("CloudConfig must not contain %s when %s is set", key, field)
+	return err
+}
+
+// multipart composes parts into a multipart/mixed MIME document. The
+// boundary is derived from the content so that the document is reproducible.
+func multipart(parts []part) ([]byte, error) {
+	h := fnv.New64a()
+	for _, p := range parts {
+		h.Write(p.body)
+	}
+	boundary := fmt.Sprintf("===============%020d==", h.Sum64())
+	for _, p := range parts {
+		if bytes.Contains(p.body, []byte(boundary)) {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = p.argument
+			err.Value = p.filename
+			err.Info = fmt.Sprintf("Part %s contains the MIME boundary %s", p.filename, boundary)
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=\"%s\"\n", boundary)
+	buf.WriteString("MIME-Version: 1.0\n")
+
+	for _, p := range parts {
+		charset, encoding := "us-ascii", "7bit"
+		if !isASCII(p.body) {
+			charset, encoding = "utf-8", "8bit"
+		}
+		fmt.Fprintf(&buf, "\n--%s\n", boundary)
+		fmt.Fprintf(&buf, "Content-Type: %s; charset=\"%s\"\n", p.contentType, charset)
+		buf.WriteString("MIME-Version: 1.0\n")
+		fmt.Fprintf(&buf, "Content-Transfer-Encoding: %s\n", encoding)
+		fmt.Fprintf(&buf, "Content-Disposition: attachment; filename=\"%s\"\n\n", p.filename)
+		buf.Write(p.body)
+		if !bytes.HasSuffix(p.body, []byte("\n")) {
+			buf.WriteString("\n")
+		}
+	}
+	fmt.Fprintf(&buf, "\n--%s--\n", boundary)
+
+	return buf.Bytes(), nil
+}
+
+func isASCII(b []byte) bool {
+	for _, c := range b {
+		if c >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
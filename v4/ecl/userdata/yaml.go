@@ -0,0 +1,189 @@
+package userdata
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v4"
+)
+
+// plainScalar matches strings which can be written without quotes.
+var plainScalar = regexp.MustCompile(`^[A-Za-z_/][A-Za-z0-9_./@+=-]*$`)
+
+// reserved are plain scalars which YAML 1.1 parsers read as booleans or null.
+var reserved = map[string]bool{
+	"y": true, "yes": true, "n": true, "no": true,
+	"true": true, "false": true, "on": true, "off": true,
+	"null": true,
+}
+
+// node is a rendered YAML value. Inline is written after the key or list
+// marker; Block lines follow, indented one level deeper.
+type node struct {
+	inline string
+	block  []string
+}
+
+// encodeYAML renders m as a block style YAML mapping with sorted keys.
+// Errors name the offending key below argument.
+func encodeYAML(argument string, m map[string]interface{}) ([]byte, error) {
+	n, err := render(argument, reflect.ValueOf(m))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	for _, line := range n.block {
+		buf.WriteString(line)
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// render renders v. path names v in errors.
+func render(path string, v reflect.Value) (node, error) {
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return node{inline: "null"}, nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return renderString(v.String()), nil
+	case reflect.Bool:
+		return node{inline: strconv.FormatBool(v.Bool())}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return node{inline: strconv.FormatInt(v.Int(), 10)}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return node{inline: strconv.FormatUint(v.Uint(), 10)}, nil
+	case reflect.Float32, reflect.Float64:
+		return node{inline: strconv.FormatFloat(v.Float(), 'g', -1, 64)}, nil
+	case reflect.Slice, reflect.Array:
+		return renderSequence(path, v)
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			err := eclcloud.ErrInvalidInput{}
+			err.Argument = path
+			err.Value = v.Interface()
+			err.Info = fmt.Sprintf("Unsupported cloud-config map key type %s", v.Type().Key())
+			return node{}, err
+		}
+		return renderMapping(path, v)
+	}
+	err := eclcloud.ErrInvalidInput{}
+	err.Argument = path
+	err.Value = v.Interface()
+	err.Info = fmt.Sprintf("Unsupported cloud-config value type %s", v.Type())
+	return node{}, err
+}
+
+func renderMapping(path string, v reflect.Value) (node, error) {
+	if v.Len() == 0 {
+		return node{inline: "{}"}, nil
+	}
+
+	keys := make([]string, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+
+	var n node
+	for _, k := range keys {
+		child, err := render(path+"."+k, v.MapIndex(reflect.ValueOf(k).Convert(v.Type().Key())))
+		if err != nil {
+			return node{}, err
+		}
+		key := renderString(k).inline
+		if child.inline != "" {
+			n.block = append(n.block, key+": "+child.inline)
+		} else {
+			n.block = append(n.block, key+":")
+		}
+		n.block = append(n.block, indent(child.block, "  ", "  ")...)
+	}
+	return n, nil
+}
+
+func renderSequence(path string, v reflect.Value) (node, error) {
+	if v.Len() == 0 {
+		return node{inline: "[]"}, nil
+	}
+
+	var n node
+	for i := 0; i < v.Len(); i++ {
+		child, err := render(fmt.Sprintf("%s[%d]", path, i), v.Index(i))
+		if err != nil {
+			return node{}, err
+		}
+		if child.inline != "" {
+			n.block = append(n.block, "- "+child.inline)
+			n.block = append(n.block, indent(child.block, "  ", "  ")...)
+		} else {
+			n.block = append(n.block, indent(child.block, "- ", "  ")...)
+		}
+	}
+	return n, nil
+}
+
+// renderString writes s as a plain scalar if possible, as a literal block if
+// it spans several lines, and double quoted otherwise.
+func renderString(s string) node {
+	if plainScalar.MatchString(s) && !reserved[strings.ToLower(s)] {
+		return node{inline: s}
+	}
+
+	if strings.Contains(s, "\n") && isLiteral(s) {
+		header := "|"
+		trimmed := strings.TrimRight(s, "\n")
+		switch len(s) - len(trimmed) {
+		case 0:
+			header = "|-"
+		case 1:
+		default:
+			header = "|+"
+		}
+		lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+		return node{inline: header, block: lines}
+	}
+
+	return node{inline: strconv.Quote(s)}
+}
+
+// isLiteral reports whether s can be written as a literal block without an
+// indentation indicator or escapes.
+func isLiteral(s string) bool {
+	if strings.HasPrefix(s, " ") || strings.HasPrefix(s, "\t") || strings.HasPrefix(s, "\n") {
+		return false
+	}
+	for _, r := range s {
+		if r != '\n' && (r < ' ' || r == 0x7f) && r != '\t' {
+			return false
+		}
+	}
+	return true
+}
+
+// indent prefixes the first line with first and the remaining lines with
+// rest. Empty lines are left empty.
+func indent(lines []string, first, rest string) []string {
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		prefix := rest
+		if i == 0 {
+			prefix = first
+		}
+		if line == "" {
+			out[i] = strings.TrimRight(prefix, " ")
+			continue
+		}
+		out[i] = prefix + line
+	}
+	return out
+}
@@ -1,7 +1,11 @@
 package testing
 
 import (
+	"fmt"
+	"testing"
+
 	"github.com/nttcom/eclcloud/v4"
+	"github.com/nttcom/eclcloud/v4/pagination"
 	"github.com/nttcom/eclcloud/v4/testhelper"
 )
 
@@ -11,3 +15,9 @@ func createClient() *eclcloud.ServiceClient {
 		Endpoint:       testhelper.Endpoint(),
 	}
 }
+
+func TestAllPagesPagerErr(t *testing.T) {
+	expected := fmt.Errorf("invalid list options")
+	_, err := pagination.Pager{Err: expected}.AllPages()
+	testhelper.AssertEquals(t, expected, err)
+}